@@ -5,33 +5,29 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
+	"time"
 
 	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func main() {
-	fmt.Println("Blog client")
-
-	tls := false
-	opts := grpc.WithInsecure()
-
-	if tls {
-
-		certFile := "ssl/ca.crt"
-
-		creds, sslErr := credentials.NewClientTLSFromFile(certFile, "")
-
-		if sslErr != nil {
-			log.Fatalf("Error while loading CA trust certificate: %v", sslErr)
-			return
+	if len(os.Args) > 1 && os.Args[1] == "export-site" {
+		if err := exportSite(os.Args[2:]); err != nil {
+			log.Fatalf("Error while exporting site: %v", err)
 		}
 
-		opts = grpc.WithTransportCredentials(creds)
+		return
 	}
 
-	cc, err := grpc.Dial("localhost:50051", opts)
+	fmt.Println("Blog client")
+
+	cc, err := dial("localhost:50051", false)
 
 	if err != nil {
 		log.Fatalf("Could not connect: %v", err)
@@ -41,33 +37,90 @@ func main() {
 
 	c := blogpb.NewBlogServiceClient(cc)
 
-	// blog := createNewBlog(c)
+	// token, _ := signToken(os.Getenv("BLOG_AUTH_SECRET"), "Newton", time.Hour)
+
+	// blog := createNewBlog(c, token)
 
 	// readBlog(c, blog.Blog.Id)
 
-	// updateBlog(c, &blogpb.Blog{
-	// 	Id:       blog.Blog.Id,
-	// 	AuthorId: "Newton",
-	// 	Title:    "Third blog",
-	// 	Content:  "Content of the third blog",
+	// updateBlog(c, token, &blogpb.Blog{
+	// 	Id:      blog.Blog.Id,
+	// 	Title:   "Third blog",
+	// 	Content: "Content of the third blog",
 	// })
 
-	// deleteBlog(c, blog.Blog.Id)
+	// deleteBlog(c, token, blog.Blog.Id)
 
 	listBlog(c)
+
+	// getBlogStats(c)
+
+	// getRelatedBlogs(c, blog.Blog.Id)
+
+	// reactToBlog(c, token, blog.Blog.Id, blogpb.ReactionType_REACTION_TYPE_LIKE)
+
+	// watchReactions(c, blog.Blog.Id)
+
+	// listTrendingBlogs(c)
+
+	// addTranslation(c, token, blog.Blog.Id, "de", "Dritter Blog", "Inhalt des dritten Blogs")
+
+	// readBlogInLocale(c, blog.Blog.Id, "de-CH, en;q=0.5")
+
+	// w := blogpb.NewWebhookServiceClient(cc)
+
+	// webhook := registerWebhook(w, token, "http://localhost:8080/blog-events", "secret")
+
+	// listDeliveryAttempts(w, token, webhook.Webhook.Id)
+
+	// m := blogpb.NewModerationServiceClient(cc)
+
+	// listQuarantinedBlogs(m, token)
+
+	// approveBlog(m, token, blog.Blog.Id)
+
+	// r := blogpb.NewReadingListServiceClient(cc)
+
+	// addBookmark(r, token, blog.Blog.Id)
+
+	// listBookmarks(r, token)
+
+	// sc := blogpb.NewSeriesServiceClient(cc)
+
+	// series := createSeries(sc, token, "gRPC in Go")
+
+	// addSeriesPart(sc, token, series.Series.Id, blog.Blog.Id)
 }
 
-func createNewBlog(c blogpb.BlogServiceClient) *blogpb.CreateBlogResponse {
+func dial(addr string, tls bool) (*grpc.ClientConn, error) {
+	opts := grpc.WithInsecure()
+
+	if tls {
+
+		certFile := "ssl/ca.crt"
+
+		creds, sslErr := credentials.NewClientTLSFromFile(certFile, "")
+
+		if sslErr != nil {
+			return nil, fmt.Errorf("error while loading CA trust certificate: %v", sslErr)
+		}
+
+		opts = grpc.WithTransportCredentials(creds)
+	}
+
+	return grpc.Dial(addr, opts)
+}
+
+func createNewBlog(c blogpb.BlogServiceClient, token string) *blogpb.CreateBlogResponse {
 
 	req := &blogpb.CreateBlogRequest{
 		Blog: &blogpb.Blog{
-			AuthorId: "Newton",
-			Title:    "Second blog",
-			Content:  "Content of the second blog",
+			Title:   "Second blog",
+			Content: "Content of the second blog",
 		},
 	}
 
-	res, err := c.CreateBlog(context.Background(), req)
+	res, err := c.CreateBlog(withToken(context.Background(), token), req)
 
 	if err != nil {
 		log.Fatalf("Unexpected error: %v", err)
@@ -94,13 +147,13 @@ func readBlog(c blogpb.BlogServiceClient, id string) {
 	fmt.Printf("Blog was read: %v\n", res)
 }
 
-func updateBlog(c blogpb.BlogServiceClient, blog *blogpb.Blog) {
+func updateBlog(c blogpb.BlogServiceClient, token string, blog *blogpb.Blog) {
 
 	req := &blogpb.UpdateBlogRequest{
 		Blog: blog,
 	}
 
-	res, err := c.UpdateBlog(context.Background(), req)
+	res, err := c.UpdateBlog(withToken(context.Background(), token), req)
 
 	if err != nil {
 		log.Fatalf("Error while updating blog: %v\n", err)
@@ -110,13 +163,13 @@ func updateBlog(c blogpb.BlogServiceClient, blog *blogpb.Blog) {
 	fmt.Printf("Blog was updated: %v\n", res)
 }
 
-func deleteBlog(c blogpb.BlogServiceClient, id string) {
+func deleteBlog(c blogpb.BlogServiceClient, token string, id string) {
 
 	req := &blogpb.DeleteBlogRequest{
 		BlogId: id,
 	}
 
-	res, err := c.DeleteBlog(context.Background(), req)
+	res, err := c.DeleteBlog(withToken(context.Background(), token), req)
 
 	if err != nil {
 		log.Fatalf("Error while deleting blog: %v\n", err)
@@ -128,12 +181,129 @@ func deleteBlog(c blogpb.BlogServiceClient, id string) {
 
 func listBlog(c blogpb.BlogServiceClient) {
 
-	req := &blogpb.ListBlogRequest{}
-
-	stream, err := c.ListBlog(context.Background(), req)
+	err := walkBlogs(c, func(blog *blogpb.Blog) error {
+		fmt.Printf("Blog was read: %v\n", blog)
+		return nil
+	})
 
 	if err != nil {
 		log.Fatalf("Error while listing blog: %v\n", err)
+	}
+}
+
+// walkBlogs calls fn for every blog returned by ListBlog. If the connection
+// drops mid-stream the listing resumes after the last blog received.
+func walkBlogs(c blogpb.BlogServiceClient, fn func(blog *blogpb.Blog) error) error {
+
+	lastID := ""
+	retries := 0
+
+	for {
+		req := &blogpb.ListBlogRequest{
+			ResumeAfterId: lastID,
+		}
+
+		stream, err := c.ListBlog(context.Background(), req)
+
+		if err != nil {
+			return err
+		}
+
+		for {
+			res, err := stream.Recv()
+
+			if err == io.EOF {
+				return nil
+			}
+
+			if status.Code(err) == codes.Unavailable && retries < 5 {
+				// The connection dropped mid-stream, continue after the last
+				// blog we received.
+				retries++
+				fmt.Printf("Stream interrupted, resuming after %q: %v\n", lastID, err)
+				time.Sleep(time.Duration(retries) * time.Second)
+				break
+			}
+
+			if err != nil {
+				return err
+			}
+
+			blog := res.GetBlog()
+			lastID = blog.GetId()
+
+			if err := fn(blog); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func getBlogStats(c blogpb.BlogServiceClient) {
+
+	now := time.Now()
+
+	req := &blogpb.GetBlogStatsRequest{
+		From: timestamppb.New(now.AddDate(0, 0, -7)),
+		To:   timestamppb.New(now),
+	}
+
+	res, err := c.GetBlogStats(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while getting blog stats: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Blog stats: %v\n", res)
+}
+
+func getRelatedBlogs(c blogpb.BlogServiceClient, id string) {
+
+	req := &blogpb.GetRelatedBlogsRequest{
+		BlogId: id,
+		Limit:  5,
+	}
+
+	res, err := c.GetRelatedBlogs(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while getting related blogs: %v\n", err)
+		return
+	}
+
+	for _, related := range res.GetRelated() {
+		fmt.Printf("Related blog (%.3f): %v\n", related.GetScore(), related.GetBlog())
+	}
+}
+
+func reactToBlog(c blogpb.BlogServiceClient, token string, id string, reaction blogpb.ReactionType) {
+
+	req := &blogpb.ReactToBlogRequest{
+		BlogId: id,
+		Type:   reaction,
+	}
+
+	res, err := c.ReactToBlog(withToken(context.Background(), token), req)
+
+	if err != nil {
+		log.Fatalf("Error while reacting to blog: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Reactions: %v\n", res.GetReactions())
+}
+
+func watchReactions(c blogpb.BlogServiceClient, id string) {
+
+	req := &blogpb.WatchReactionsRequest{
+		BlogId: id,
+	}
+
+	stream, err := c.WatchReactions(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while watching reactions: %v\n", err)
 		return
 	}
 
@@ -149,8 +319,224 @@ func listBlog(c blogpb.BlogServiceClient) {
 			return
 		}
 
-		blog := res.GetBlog()
+		fmt.Printf("Reactions changed: %v\n", res.GetReactions())
+	}
+}
 
-		fmt.Printf("Blog was read: %v\n", blog)
+func listTrendingBlogs(c blogpb.BlogServiceClient) {
+
+	req := &blogpb.ListTrendingBlogsRequest{
+		Window: blogpb.TrendingWindow_TRENDING_WINDOW_WEEK,
+	}
+
+	res, err := c.ListTrendingBlogs(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while listing trending blogs: %v\n", err)
+		return
 	}
+
+	for _, trending := range res.GetBlogs() {
+		fmt.Printf("Trending blog (%d views, score %.2f): %v\n", trending.GetViews(), trending.GetScore(), trending.GetBlog().GetTitle())
+	}
+}
+
+func registerWebhook(w blogpb.WebhookServiceClient, token string, url string, secret string) *blogpb.RegisterWebhookResponse {
+
+	req := &blogpb.RegisterWebhookRequest{
+		Webhook: &blogpb.Webhook{
+			Url:    url,
+			Secret: secret,
+			EventTypes: []blogpb.BlogEventType{
+				blogpb.BlogEventType_BLOG_EVENT_TYPE_CREATED,
+				blogpb.BlogEventType_BLOG_EVENT_TYPE_UPDATED,
+				blogpb.BlogEventType_BLOG_EVENT_TYPE_DELETED,
+			},
+		},
+	}
+
+	res, err := w.RegisterWebhook(withToken(context.Background(), token), req)
+
+	if err != nil {
+		log.Fatalf("Error while registering webhook: %v\n", err)
+	}
+
+	fmt.Printf("Webhook has been registered: %v\n", res)
+
+	return res
+}
+
+func listDeliveryAttempts(w blogpb.WebhookServiceClient, token string, webhookID string) {
+
+	req := &blogpb.ListDeliveryAttemptsRequest{
+		WebhookId: webhookID,
+	}
+
+	res, err := w.ListDeliveryAttempts(withToken(context.Background(), token), req)
+
+	if err != nil {
+		log.Fatalf("Error while listing delivery attempts: %v\n", err)
+		return
+	}
+
+	for _, attempt := range res.GetAttempts() {
+		fmt.Printf("Delivery attempt: %v\n", attempt)
+	}
+}
+
+func listQuarantinedBlogs(m blogpb.ModerationServiceClient, token string) {
+
+	ctx := withToken(context.Background(), token)
+	afterID := ""
+
+	for {
+		req := &blogpb.ListQuarantinedBlogsRequest{
+			AfterId: afterID,
+		}
+
+		res, err := m.ListQuarantinedBlogs(ctx, req)
+
+		if err != nil {
+			log.Fatalf("Error while listing quarantined blogs: %v\n", err)
+		}
+
+		if len(res.GetBlogs()) == 0 {
+			return
+		}
+
+		for _, blog := range res.GetBlogs() {
+			fmt.Printf("Quarantined blog: %v\n", blog)
+		}
+
+		afterID = res.GetBlogs()[len(res.GetBlogs())-1].GetId()
+	}
+}
+
+func approveBlog(m blogpb.ModerationServiceClient, token string, id string) {
+
+	req := &blogpb.ApproveBlogRequest{
+		BlogId: id,
+	}
+
+	res, err := m.ApproveBlog(withToken(context.Background(), token), req)
+
+	if err != nil {
+		log.Fatalf("Error while approving blog: %v\n", err)
+	}
+
+	fmt.Printf("Blog was approved: %v\n", res)
+}
+
+func addTranslation(c blogpb.BlogServiceClient, token string, id string, lang string, title string, content string) {
+
+	req := &blogpb.AddTranslationRequest{
+		BlogId: id,
+		Translation: &blogpb.Translation{
+			Language: lang,
+			Title:    title,
+			Content:  content,
+		},
+	}
+
+	res, err := c.AddTranslation(withToken(context.Background(), token), req)
+
+	if err != nil {
+		log.Fatalf("Error while adding translation: %v\n", err)
+	}
+
+	fmt.Printf("Translation was added: %v\n", res)
+}
+
+func readBlogInLocale(c blogpb.BlogServiceClient, id string, locale string) {
+
+	req := &blogpb.ReadBlogRequest{
+		BlogId: id,
+		Locale: locale,
+	}
+
+	res, err := c.ReadBlog(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while reading blog: %v\n", err)
+	}
+
+	fmt.Printf("Blog was read in %v: %v\n", res.GetBlog().GetLanguage(), res)
+}
+
+func addBookmark(r blogpb.ReadingListServiceClient, token string, id string) {
+
+	req := &blogpb.AddBookmarkRequest{
+		BlogId: id,
+	}
+
+	res, err := r.AddBookmark(withToken(context.Background(), token), req)
+
+	if err != nil {
+		log.Fatalf("Error while adding bookmark: %v\n", err)
+	}
+
+	fmt.Printf("Bookmark was added: %v\n", res)
+}
+
+func listBookmarks(r blogpb.ReadingListServiceClient, token string) {
+
+	ctx := withToken(context.Background(), token)
+	pageToken := ""
+
+	for {
+		req := &blogpb.ListBookmarksRequest{
+			PageToken: pageToken,
+		}
+
+		res, err := r.ListBookmarks(ctx, req)
+
+		if err != nil {
+			log.Fatalf("Error while listing bookmarks: %v\n", err)
+		}
+
+		for _, bookmark := range res.GetBookmarks() {
+			fmt.Printf("Bookmark: %v\n", bookmark)
+		}
+
+		if res.GetNextPageToken() == "" {
+			return
+		}
+
+		pageToken = res.GetNextPageToken()
+	}
+}
+
+func createSeries(sc blogpb.SeriesServiceClient, token string, title string) *blogpb.CreateSeriesResponse {
+
+	req := &blogpb.CreateSeriesRequest{
+		Series: &blogpb.Series{
+			Title: title,
+		},
+	}
+
+	res, err := sc.CreateSeries(withToken(context.Background(), token), req)
+
+	if err != nil {
+		log.Fatalf("Error while creating series: %v\n", err)
+	}
+
+	fmt.Printf("Series has been created: %v\n", res)
+
+	return res
+}
+
+func addSeriesPart(sc blogpb.SeriesServiceClient, token string, seriesID string, blogID string) {
+
+	req := &blogpb.AddSeriesPartRequest{
+		SeriesId: seriesID,
+		BlogId:   blogID,
+	}
+
+	res, err := sc.AddSeriesPart(withToken(context.Background(), token), req)
+
+	if err != nil {
+		log.Fatalf("Error while adding series part: %v\n", err)
+	}
+
+	fmt.Printf("Series part was added: %v\n", res)
 }
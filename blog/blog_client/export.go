@@ -0,0 +1,431 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"html/template"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
+)
+
+//go:embed templates/*.html
+var defaultTemplates embed.FS
+
+// siteInfo is available to every template as .Site.
+type siteInfo struct {
+	Title       string
+	Root        string
+	GeneratedAt time.Time
+}
+
+type postView struct {
+	ID        string
+	Title     string
+	Content   string
+	Author    string
+	AuthorURL string
+	URL       string
+	Tags      []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// listPage is the data of list.html, used for the index and author pages.
+type listPage struct {
+	Site       siteInfo
+	Heading    string
+	Posts      []*postView
+	Page       int
+	TotalPages int
+	PrevURL    string
+	NextURL    string
+}
+
+// postPage is the data of post.html.
+type postPage struct {
+	Site siteInfo
+	Post *postView
+}
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// siteExporter renders blogs into a directory of static files.
+type siteExporter struct {
+	out      string
+	baseURL  *url.URL
+	pageSize int
+	site     siteInfo
+
+	list *template.Template
+	post *template.Template
+
+	sitemap []sitemapURL
+}
+
+// exportSite implements the export-site command:
+//
+//	blog_client export-site [-server addr] [-out dir] [-base-url url] [-page-size n] [-templates dir] [-title title]
+//
+// It writes index.html with pagination under page/<n>/, one page per post
+// under blogs/<id>/, one listing per author under authors/<slug>/ and a
+// sitemap.xml. Any of layout.html, list.html and post.html found in the
+// templates directory replaces the built-in version.
+func exportSite(args []string) error {
+	fs := flag.NewFlagSet("export-site", flag.ExitOnError)
+	server := fs.String("server", "localhost:50051", "Address of the blog server")
+	tls := fs.Bool("tls", false, "Connect to the server over TLS")
+	out := fs.String("out", "site", "Output directory")
+	baseURL := fs.String("base-url", "http://localhost:8000/", "URL the site will be served from")
+	pageSize := fs.Int("page-size", 10, "Number of posts per index page")
+	templatesDir := fs.String("templates", "", "Directory with templates overriding the built-in ones")
+	title := fs.String("title", "Blog", "Site title")
+
+	fs.Parse(args)
+
+	if *pageSize < 1 {
+		return fmt.Errorf("page-size must be positive")
+	}
+
+	base, err := url.Parse(*baseURL)
+
+	if err != nil || base.Scheme == "" || base.Host == "" {
+		return fmt.Errorf("invalid base URL: %v", *baseURL)
+	}
+
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+
+	e := &siteExporter{
+		out:      *out,
+		baseURL:  base,
+		pageSize: *pageSize,
+		site: siteInfo{
+			Title:       *title,
+			Root:        base.Path,
+			GeneratedAt: time.Now(),
+		},
+	}
+
+	if e.list, err = loadTemplate(*templatesDir, "list.html"); err != nil {
+		return err
+	}
+
+	if e.post, err = loadTemplate(*templatesDir, "post.html"); err != nil {
+		return err
+	}
+
+	cc, err := dial(*server, *tls)
+
+	if err != nil {
+		return err
+	}
+
+	defer cc.Close()
+
+	c := blogpb.NewBlogServiceClient(cc)
+
+	posts := []*postView{}
+
+	err = walkBlogs(c, func(blog *blogpb.Blog) error {
+		posts = append(posts, e.postView(blog))
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("listing blogs: %v", err)
+	}
+
+	// ListBlog returns the oldest post first.
+	for i, j := 0, len(posts)-1; i < j; i, j = i+1, j-1 {
+		posts[i], posts[j] = posts[j], posts[i]
+	}
+
+	if err := e.writeLists("", *title, posts); err != nil {
+		return err
+	}
+
+	byAuthor := map[string][]*postView{}
+
+	for _, post := range posts {
+		byAuthor[post.Author] = append(byAuthor[post.Author], post)
+
+		page := postPage{
+			Site: e.site,
+			Post: post,
+		}
+
+		if err := e.render(e.post, path.Join("blogs", post.ID, "index.html"), page); err != nil {
+			return err
+		}
+
+		e.addToSitemap(post.URL, post.UpdatedAt)
+	}
+
+	for author, authorPosts := range byAuthor {
+		dir := path.Join("authors", authorSlug(author))
+
+		if err := e.writeLists(dir, "Posts by "+author, authorPosts); err != nil {
+			return err
+		}
+	}
+
+	if err := e.writeSitemap(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported %d posts by %d authors to %v\n", len(posts), len(byAuthor), e.out)
+
+	return nil
+}
+
+func loadTemplate(dir string, name string) (*template.Template, error) {
+	funcs := template.FuncMap{
+		"paragraphs": paragraphs,
+		"summary":    summary,
+	}
+
+	t := template.New("layout.html").Funcs(funcs)
+
+	for _, file := range []string{"layout.html", name} {
+		content, err := defaultTemplates.ReadFile("templates/" + file)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if dir != "" {
+			custom, err := os.ReadFile(filepath.Join(dir, file))
+
+			if err == nil {
+				content = custom
+			} else if !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+
+		if _, err := t.Parse(string(content)); err != nil {
+			return nil, fmt.Errorf("parsing %v: %v", file, err)
+		}
+	}
+
+	return t, nil
+}
+
+// paragraphs splits plain text content on blank lines.
+func paragraphs(content string) []string {
+	result := []string{}
+
+	for _, p := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
+// summary shortens content to at most n characters, cutting at a word
+// boundary where possible.
+func summary(content string, n int) string {
+	content = strings.Join(strings.Fields(content), " ")
+
+	if utf8.RuneCountInString(content) <= n {
+		return content
+	}
+
+	cut := string([]rune(content)[:n])
+
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+
+	return cut + "…"
+}
+
+// authorSlug turns an author ID into a safe directory name. Characters other
+// than letters, digits, '-' and '_' are replaced, and a hash of the ID is
+// appended when that happens so different authors cannot collide.
+func authorSlug(author string) string {
+	var b strings.Builder
+	replaced := false
+
+	for _, c := range author {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+			b.WriteRune(c)
+		default:
+			b.WriteByte('-')
+			replaced = true
+		}
+	}
+
+	if b.Len() == 0 || replaced {
+		sum := sha256.Sum256([]byte(author))
+		b.WriteString("-" + hex.EncodeToString(sum[:4]))
+	}
+
+	return b.String()
+}
+
+func (e *siteExporter) postView(blog *blogpb.Blog) *postView {
+	author := blog.GetAuthorId()
+
+	if author == "" {
+		author = "Anonymous"
+	}
+
+	post := &postView{
+		ID:        blog.GetId(),
+		Title:     blog.GetTitle(),
+		Content:   blog.GetContent(),
+		Author:    author,
+		AuthorURL: e.site.Root + "authors/" + authorSlug(author) + "/",
+		URL:       e.site.Root + "blogs/" + blog.GetId() + "/",
+		Tags:      blog.GetTags(),
+		CreatedAt: blog.GetCreatedAt().AsTime(),
+		UpdatedAt: blog.GetUpdatedAt().AsTime(),
+	}
+
+	if blog.GetUpdatedAt() == nil {
+		post.UpdatedAt = post.CreatedAt
+	}
+
+	return post
+}
+
+// writeLists renders paginated listings of posts into dir, with the first
+// page at dir/index.html and page n at dir/page/n/index.html.
+func (e *siteExporter) writeLists(dir string, heading string, posts []*postView) error {
+	totalPages := (len(posts) + e.pageSize - 1) / e.pageSize
+
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	pageURL := func(page int) string {
+		u := e.site.Root
+
+		if dir != "" {
+			u += dir + "/"
+		}
+
+		if page > 1 {
+			u += fmt.Sprintf("page/%d/", page)
+		}
+
+		return u
+	}
+
+	for page := 1; page <= totalPages; page++ {
+		start := (page - 1) * e.pageSize
+		end := start + e.pageSize
+
+		if end > len(posts) {
+			end = len(posts)
+		}
+
+		data := listPage{
+			Site:       e.site,
+			Heading:    heading,
+			Posts:      posts[start:end],
+			Page:       page,
+			TotalPages: totalPages,
+		}
+
+		if page > 1 {
+			data.PrevURL = pageURL(page - 1)
+		}
+
+		if page < totalPages {
+			data.NextURL = pageURL(page + 1)
+		}
+
+		file := path.Join(dir, "index.html")
+
+		if page > 1 {
+			file = path.Join(dir, "page", fmt.Sprint(page), "index.html")
+		}
+
+		if err := e.render(e.list, file, data); err != nil {
+			return err
+		}
+
+		if page == 1 {
+			var lastMod time.Time
+
+			if len(posts) > 0 {
+				lastMod = posts[0].UpdatedAt
+			}
+
+			e.addToSitemap(pageURL(page), lastMod)
+		}
+	}
+
+	return nil
+}
+
+func (e *siteExporter) render(t *template.Template, name string, data interface{}) error {
+	var buf bytes.Buffer
+
+	if err := t.ExecuteTemplate(&buf, "layout.html", data); err != nil {
+		return fmt.Errorf("rendering %v: %v", name, err)
+	}
+
+	return e.writeFile(name, buf.Bytes())
+}
+
+func (e *siteExporter) writeFile(name string, content []byte) error {
+	file := filepath.Join(e.out, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(file, content, 0644)
+}
+
+// addToSitemap records a root-relative URL for sitemap.xml.
+func (e *siteExporter) addToSitemap(rootRelative string, lastMod time.Time) {
+	loc := e.baseURL.ResolveReference(&url.URL{Path: rootRelative})
+
+	entry := sitemapURL{
+		Loc: loc.String(),
+	}
+
+	if !lastMod.IsZero() {
+		entry.LastMod = lastMod.UTC().Format("2006-01-02")
+	}
+
+	e.sitemap = append(e.sitemap, entry)
+}
+
+func (e *siteExporter) writeSitemap() error {
+	body, err := xml.MarshalIndent(sitemapURLSet{URLs: e.sitemap}, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return e.writeFile("sitemap.xml", append([]byte(xml.Header), body...))
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authenticator identifies users by the bearer token in the authorization
+// metadata. Tokens are JWTs signed with HMAC-SHA256 using secret; the sub
+// claim is the user ID and the exp claim is required.
+type authenticator struct {
+	secret []byte
+}
+
+func newAuthenticator(secret string) *authenticator {
+	return &authenticator{secret: []byte(secret)}
+}
+
+type tokenHeader struct {
+	Alg string `json:"alg"`
+}
+
+type tokenClaims struct {
+	Subject   string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+	NotBefore int64  `json:"nbf"`
+}
+
+// UserID returns the authenticated user of a call, or an Unauthenticated
+// error.
+func (a *authenticator) UserID(ctx context.Context) (string, error) {
+	if len(a.secret) == 0 {
+		return "", status.Errorf(
+			codes.Unauthenticated,
+			"Authentication is not configured on this server",
+		)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+
+	if len(values) == 0 || !strings.HasPrefix(values[0], "Bearer ") {
+		return "", status.Errorf(
+			codes.Unauthenticated,
+			"Bearer token is required",
+		)
+	}
+
+	claims, err := a.verify(strings.TrimPrefix(values[0], "Bearer "), time.Now())
+
+	if err != nil {
+		return "", status.Errorf(
+			codes.Unauthenticated,
+			fmt.Sprintf("Invalid token: %v", err),
+		)
+	}
+
+	return claims.Subject, nil
+}
+
+// userSet holds the users allowed to call a restricted service.
+type userSet map[string]bool
+
+// parseUserSet reads a comma-separated list of user IDs.
+func parseUserSet(list string) userSet {
+	users := userSet{}
+
+	for _, userID := range strings.Split(list, ",") {
+		if userID = strings.TrimSpace(userID); userID != "" {
+			users[userID] = true
+		}
+	}
+
+	return users
+}
+
+// Authorize returns the authenticated user of a call if they are one of
+// allowed, or an Unauthenticated or PermissionDenied error.
+func (a *authenticator) Authorize(ctx context.Context, allowed userSet) (string, error) {
+	userID, err := a.UserID(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !allowed[userID] {
+		return "", status.Errorf(
+			codes.PermissionDenied,
+			fmt.Sprintf("User %v is not allowed to call this service", userID),
+		)
+	}
+
+	return userID, nil
+}
+
+func (a *authenticator) verify(token string, now time.Time) (*tokenClaims, error) {
+	parts := strings.Split(token, ".")
+
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	header := &tokenHeader{}
+
+	if err := decodeTokenPart(parts[0], header); err != nil {
+		return nil, err
+	}
+
+	// Only accept the algorithm we sign with, never "none".
+	if header.Alg != "HS256" {
+		return nil, errors.New("unsupported algorithm")
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+
+	if err != nil {
+		return nil, errors.New("malformed signature")
+	}
+
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errors.New("bad signature")
+	}
+
+	claims := &tokenClaims{}
+
+	if err := decodeTokenPart(parts[1], claims); err != nil {
+		return nil, err
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("missing subject")
+	}
+
+	if claims.ExpiresAt == 0 || now.Unix() >= claims.ExpiresAt {
+		return nil, errors.New("expired")
+	}
+
+	if claims.NotBefore != 0 && now.Unix() < claims.NotBefore {
+		return nil, errors.New("not valid yet")
+	}
+
+	return claims, nil
+}
+
+func decodeTokenPart(part string, v interface{}) error {
+	content, err := base64.RawURLEncoding.DecodeString(part)
+
+	if err != nil {
+		return errors.New("malformed token")
+	}
+
+	if err := json.Unmarshal(content, v); err != nil {
+		return errors.New("malformed token")
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// cacheEntry is a single cached ReadBlog result. A nil data field records that
+// the blog was not found.
+type cacheEntry struct {
+	id        primitive.ObjectID
+	data      *blogItem
+	expiresAt time.Time
+}
+
+// cachedStore is a read-through LRU cache in front of another blogStore.
+// Successful reads are kept for ttl and NotFound results for negativeTTL.
+// Writes through the cache invalidate the affected entry.
+type cachedStore struct {
+	blogStore
+
+	size        int
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[primitive.ObjectID]*list.Element
+	// version is bumped on every invalidation so that a read which raced
+	// with a write does not put stale data back into the cache.
+	version uint64
+
+	hits   uint64
+	misses uint64
+}
+
+func newCachedStore(store blogStore, size int, ttl time.Duration, negativeTTL time.Duration) *cachedStore {
+	return &cachedStore{
+		blogStore:   store,
+		size:        size,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		lru:         list.New(),
+		entries:     make(map[primitive.ObjectID]*list.Element),
+	}
+}
+
+func copyBlogItem(data *blogItem) *blogItem {
+	if data == nil {
+		return nil
+	}
+
+	c := *data
+	c.Tags = append([]string(nil), data.Tags...)
+	c.ModerationReasons = append([]string(nil), data.ModerationReasons...)
+	c.ReactionCounts = copyCounts(data.ReactionCounts)
+
+	if data.Translations != nil {
+		c.Translations = make(map[string]blogTranslation, len(data.Translations))
+
+		for tag, translation := range data.Translations {
+			c.Translations[tag] = translation
+		}
+	}
+
+	return &c
+}
+
+func copyCounts(counts map[string]int64) map[string]int64 {
+	c := make(map[string]int64, len(counts))
+
+	for k, v := range counts {
+		c[k] = v
+	}
+
+	return c
+}
+
+func (c *cachedStore) ReadBlog(ctx context.Context, id primitive.ObjectID) (*blogItem, error) {
+	c.mu.Lock()
+
+	if el, ok := c.entries[id]; ok {
+		entry := el.Value.(*cacheEntry)
+
+		if time.Now().Before(entry.expiresAt) {
+			c.lru.MoveToFront(el)
+			c.mu.Unlock()
+
+			atomic.AddUint64(&c.hits, 1)
+
+			if entry.data == nil {
+				return nil, errBlogNotFound
+			}
+
+			return copyBlogItem(entry.data), nil
+		}
+
+		c.removeElement(el)
+	}
+
+	version := c.version
+	c.mu.Unlock()
+
+	atomic.AddUint64(&c.misses, 1)
+
+	data, err := c.blogStore.ReadBlog(ctx, id)
+
+	switch err {
+	case nil:
+		c.add(id, copyBlogItem(data), c.ttl, version)
+	case errBlogNotFound:
+		c.add(id, nil, c.negativeTTL, version)
+	}
+
+	return data, err
+}
+
+func (c *cachedStore) CreateBlog(ctx context.Context, data *blogItem) (primitive.ObjectID, error) {
+	oid, err := c.blogStore.CreateBlog(ctx, data)
+
+	if err == nil {
+		c.invalidate(oid)
+	}
+
+	return oid, err
+}
+
+func (c *cachedStore) UpdateBlog(ctx context.Context, data *blogItem) error {
+	defer c.invalidate(data.ID)
+
+	return c.blogStore.UpdateBlog(ctx, data)
+}
+
+func (c *cachedStore) SetTranslation(ctx context.Context, data *blogItem, tag string, translation *blogTranslation) error {
+	defer c.invalidate(data.ID)
+
+	return c.blogStore.SetTranslation(ctx, data, tag, translation)
+}
+
+func (c *cachedStore) DeleteBlog(ctx context.Context, id primitive.ObjectID) error {
+	defer c.invalidate(id)
+
+	return c.blogStore.DeleteBlog(ctx, id)
+}
+
+func (c *cachedStore) AddReaction(ctx context.Context, blogID primitive.ObjectID, userID string, reaction string) (map[string]int64, bool, error) {
+	defer c.invalidate(blogID)
+
+	return c.blogStore.AddReaction(ctx, blogID, userID, reaction)
+}
+
+func (c *cachedStore) RemoveReaction(ctx context.Context, blogID primitive.ObjectID, userID string, reaction string) (map[string]int64, bool, error) {
+	defer c.invalidate(blogID)
+
+	return c.blogStore.RemoveReaction(ctx, blogID, userID, reaction)
+}
+
+func (c *cachedStore) SetBlogStatus(ctx context.Context, id primitive.ObjectID, from string, revision int64, to string, reasons []string) error {
+	defer c.invalidate(id)
+
+	return c.blogStore.SetBlogStatus(ctx, id, from, revision, to, reasons)
+}
+
+// Stats returns the number of cache hits and misses since the cache was created.
+func (c *cachedStore) Stats() (hits uint64, misses uint64) {
+	return atomic.LoadUint64(&c.hits), atomic.LoadUint64(&c.misses)
+}
+
+func (c *cachedStore) add(id primitive.ObjectID, data *blogItem, ttl time.Duration, version uint64) {
+	if ttl <= 0 || c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.version != version {
+		return
+	}
+
+	entry := &cacheEntry{
+		id:        id,
+		data:      data,
+		expiresAt: time.Now().Add(ttl),
+	}
+
+	if el, ok := c.entries[id]; ok {
+		el.Value = entry
+		c.lru.MoveToFront(el)
+		return
+	}
+
+	c.entries[id] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.size {
+		c.removeElement(c.lru.Back())
+	}
+}
+
+func (c *cachedStore) invalidate(id primitive.ObjectID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+
+	if el, ok := c.entries[id]; ok {
+		c.removeElement(el)
+	}
+}
+
+func (c *cachedStore) removeElement(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*cacheEntry).id)
+}
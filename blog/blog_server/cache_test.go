@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// blockingStore holds ReadBlog after it has read the blog, until release is
+// closed, so that a write can be made while the read is in flight.
+type blockingStore struct {
+	*memoryStore
+
+	read    chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingStore) ReadBlog(ctx context.Context, id primitive.ObjectID) (*blogItem, error) {
+	data, err := b.memoryStore.ReadBlog(ctx, id)
+
+	b.read <- struct{}{}
+	<-b.release
+
+	return data, err
+}
+
+func createTestBlog(t *testing.T, store blogStore, title string) primitive.ObjectID {
+	t.Helper()
+
+	oid, err := store.CreateBlog(context.Background(), &blogItem{AuthorID: "author", Title: title, Content: "content"})
+
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+
+	return oid
+}
+
+func TestCachedStoreInvalidatesOnWrite(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		write func(store blogStore, data *blogItem) error
+		check func(data *blogItem, err error) error
+	}{
+		{
+			name: "update",
+			write: func(store blogStore, data *blogItem) error {
+				data.Title = "updated"
+				return store.UpdateBlog(ctx, data)
+			},
+			check: func(data *blogItem, err error) error {
+				if err != nil || data.Title != "updated" {
+					return fmt.Errorf("got %v, %v, want the updated title", data, err)
+				}
+
+				return nil
+			},
+		},
+		{
+			name: "delete",
+			write: func(store blogStore, data *blogItem) error {
+				return store.DeleteBlog(ctx, data.ID)
+			},
+			check: func(data *blogItem, err error) error {
+				if err != errBlogNotFound {
+					return fmt.Errorf("got %v, %v, want errBlogNotFound", data, err)
+				}
+
+				return nil
+			},
+		},
+		{
+			name: "reaction",
+			write: func(store blogStore, data *blogItem) error {
+				_, _, err := store.AddReaction(ctx, data.ID, "user", "like")
+				return err
+			},
+			check: func(data *blogItem, err error) error {
+				if err != nil || data.ReactionCounts["like"] != 1 {
+					return fmt.Errorf("got %v, %v, want one like", data, err)
+				}
+
+				return nil
+			},
+		},
+		{
+			name: "status",
+			write: func(store blogStore, data *blogItem) error {
+				return store.SetBlogStatus(ctx, data.ID, data.Status, data.Revision, blogStatusQuarantined, []string{"test"})
+			},
+			check: func(data *blogItem, err error) error {
+				if err != nil || data.Status != blogStatusQuarantined {
+					return fmt.Errorf("got %v, %v, want a quarantined blog", data, err)
+				}
+
+				return nil
+			},
+		},
+		{
+			name: "translation",
+			write: func(store blogStore, data *blogItem) error {
+				return store.SetTranslation(ctx, data, "de", &blogTranslation{Title: "übersetzt"})
+			},
+			check: func(data *blogItem, err error) error {
+				if err != nil || data.Translations["de"].Title != "übersetzt" {
+					return fmt.Errorf("got %v, %v, want the translated title", data, err)
+				}
+
+				return nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newCachedStore(newMemoryStore(), 10, time.Minute, time.Minute)
+			oid := createTestBlog(t, store, "original")
+
+			data, err := store.ReadBlog(ctx, oid)
+
+			if err != nil {
+				t.Fatalf("ReadBlog: %v", err)
+			}
+
+			if err := tt.write(store, data); err != nil {
+				t.Fatalf("write: %v", err)
+			}
+
+			if err := tt.check(store.ReadBlog(ctx, oid)); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestCachedStoreNegativeEntry(t *testing.T) {
+	ctx := context.Background()
+	store := newCachedStore(newMemoryStore(), 10, time.Minute, time.Minute)
+	oid := primitive.NewObjectID()
+
+	if _, err := store.ReadBlog(ctx, oid); err != errBlogNotFound {
+		t.Fatalf("ReadBlog of a missing blog: got %v, want errBlogNotFound", err)
+	}
+
+	if _, err := store.CreateBlog(ctx, &blogItem{ID: oid, Title: "created"}); err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+
+	data, err := store.ReadBlog(ctx, oid)
+
+	if err != nil || data.Title != "created" {
+		t.Errorf("ReadBlog after CreateBlog: got %v, %v, want the created blog", data, err)
+	}
+}
+
+func TestCachedStoreEvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	store := newCachedStore(newMemoryStore(), 2, time.Minute, time.Minute)
+
+	a := createTestBlog(t, store, "a")
+	b := createTestBlog(t, store, "b")
+	c := createTestBlog(t, store, "c")
+
+	for _, oid := range []primitive.ObjectID{a, b, a, c} {
+		if _, err := store.ReadBlog(ctx, oid); err != nil {
+			t.Fatalf("ReadBlog: %v", err)
+		}
+	}
+
+	if _, ok := store.entries[b]; ok {
+		t.Error("b was read least recently but is still cached")
+	}
+
+	for _, oid := range []primitive.ObjectID{a, c} {
+		if _, ok := store.entries[oid]; !ok {
+			t.Errorf("%v was read recently but is not cached", oid.Hex())
+		}
+	}
+}
+
+// TestCachedStoreReadRacingWrite checks that a read which started before a
+// write, and returns the data from before it, does not put that data back into
+// the cache.
+func TestCachedStoreReadRacingWrite(t *testing.T) {
+	ctx := context.Background()
+
+	backend := &blockingStore{
+		memoryStore: newMemoryStore(),
+		read:        make(chan struct{}),
+		release:     make(chan struct{}),
+	}
+
+	oid := createTestBlog(t, backend.memoryStore, "stale")
+	store := newCachedStore(backend, 10, time.Minute, time.Minute)
+
+	done := make(chan *blogItem)
+
+	go func() {
+		data, _ := store.ReadBlog(ctx, oid)
+		done <- data
+	}()
+
+	<-backend.read
+
+	if err := store.UpdateBlog(ctx, &blogItem{ID: oid, AuthorID: "author", Title: "fresh"}); err != nil {
+		t.Fatalf("UpdateBlog: %v", err)
+	}
+
+	close(backend.release)
+
+	if data := <-done; data.Title != "stale" {
+		t.Fatalf("racing read got %q, want the stale title it read", data.Title)
+	}
+
+	// The next read misses the cache and goes back to the store.
+	go func() { <-backend.read }()
+
+	data, err := store.ReadBlog(ctx, oid)
+
+	if err != nil || data.Title != "fresh" {
+		t.Errorf("ReadBlog after the write: got %v, %v, want the fresh title", data, err)
+	}
+}
+
+// TestCachedStoreConcurrentWrites has readers and writers race on one blog and
+// checks that once they are done the cache serves the last write.
+func TestCachedStoreConcurrentWrites(t *testing.T) {
+	ctx := context.Background()
+	store := newCachedStore(newMemoryStore(), 10, time.Minute, time.Minute)
+	oid := createTestBlog(t, store, "title 0")
+
+	var wg sync.WaitGroup
+	var writes sync.Mutex
+
+	last := 0
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 100; i++ {
+				writes.Lock()
+				last++
+				title := fmt.Sprintf("title %d", last)
+				err := store.UpdateBlog(ctx, &blogItem{ID: oid, AuthorID: "author", Title: title})
+				writes.Unlock()
+
+				if err != nil {
+					t.Errorf("UpdateBlog: %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 200; i++ {
+				if _, err := store.ReadBlog(ctx, oid); err != nil {
+					t.Errorf("ReadBlog: %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	want := fmt.Sprintf("title %d", last)
+
+	for i := 0; i < 2; i++ {
+		data, err := store.ReadBlog(ctx, oid)
+
+		if err != nil || data.Title != want {
+			t.Fatalf("ReadBlog %d after the writes: got %v, %v, want %q", i, data, err, want)
+		}
+	}
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxFeedSize is the upper bound for the feed-size flag and the limit query
+// parameter.
+const maxFeedSize = 100
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	DCNS    string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	SelfLink      atomLink  `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	Creator     string   `xml:"dc:creator,omitempty"`
+	Categories  []string `xml:"category"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     atomPerson     `xml:"author"`
+	Links      []atomLink     `xml:"link"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomContent    `xml:"content"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// feedHandler serves RSS 2.0 and Atom feeds of the latest blogs:
+//
+//	/feeds/rss.xml
+//	/feeds/atom.xml
+//	/feeds/authors/<author id>/rss.xml
+//	/feeds/authors/<author id>/atom.xml
+//
+// The number of entries defaults to maxItems and can be lowered with the
+// limit query parameter.
+type feedHandler struct {
+	store    blogStore
+	siteURL  string
+	maxItems int
+}
+
+func newFeedHandler(store blogStore, siteURL string, maxItems int) *feedHandler {
+	return &feedHandler{
+		store:    store,
+		siteURL:  strings.TrimSuffix(siteURL, "/"),
+		maxItems: maxItems,
+	}
+}
+
+func (h *feedHandler) blogURL(data *blogItem) string {
+	return h.siteURL + "/blogs/" + data.ID.Hex()
+}
+
+func (h *feedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	authorID, format, ok := parseFeedPath(r.URL.EscapedPath())
+
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	limit := h.maxItems
+
+	if value := r.URL.Query().Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+
+		if err != nil || n < 1 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+
+		if n < limit {
+			limit = n
+		}
+	}
+
+	blogs, err := h.store.LatestBlogs(r.Context(), authorID, limit)
+
+	if err != nil {
+		fmt.Printf("Failed to load blogs for feed: %v\n", err)
+
+		if mongoUnavailable(err) {
+			http.Error(w, "database unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	var lastModified time.Time
+
+	for _, data := range blogs {
+		if updated := data.updatedAt(); updated.After(lastModified) {
+			lastModified = updated
+		}
+	}
+
+	title := "Blog"
+
+	if authorID != "" {
+		title = "Blog posts by " + authorID
+	}
+
+	selfURL := h.siteURL + r.URL.EscapedPath()
+
+	var feed interface{}
+	var contentType string
+
+	switch format {
+	case "rss":
+		feed = h.rss(title, selfURL, blogs, lastModified)
+		contentType = "application/rss+xml; charset=utf-8"
+	default:
+		feed = h.atom(title, selfURL, blogs, lastModified)
+		contentType = "application/atom+xml; charset=utf-8"
+	}
+
+	body, err := xml.MarshalIndent(feed, "", "  ")
+
+	if err != nil {
+		fmt.Printf("Failed to encode feed: %v\n", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	body = append([]byte(xml.Header), body...)
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age=60")
+
+	if !lastModified.IsZero() {
+		w.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(r, etag, lastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method != http.MethodHead {
+		w.Write(body)
+	}
+}
+
+// parseFeedPath returns the author of a feed path, empty for the global feed,
+// and the feed format, rss or atom.
+func parseFeedPath(path string) (authorID string, format string, ok bool) {
+	rest := strings.TrimPrefix(path, "/feeds/")
+
+	if rest == path {
+		return "", "", false
+	}
+
+	if strings.HasPrefix(rest, "authors/") {
+		parts := strings.Split(strings.TrimPrefix(rest, "authors/"), "/")
+
+		if len(parts) != 2 || parts[0] == "" {
+			return "", "", false
+		}
+
+		author, err := url.PathUnescape(parts[0])
+
+		if err != nil {
+			return "", "", false
+		}
+
+		authorID, rest = author, parts[1]
+	}
+
+	switch rest {
+	case "rss.xml":
+		return authorID, "rss", true
+	case "atom.xml":
+		return authorID, "atom", true
+	}
+
+	return "", "", false
+}
+
+// notModified evaluates the conditional request headers. If-None-Match takes
+// precedence over If-Modified-Since as required by RFC 7232.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if since := r.Header.Get("If-Modified-Since"); since != "" && !lastModified.IsZero() {
+		t, err := http.ParseTime(since)
+
+		// HTTP dates have second precision.
+		return err == nil && !lastModified.Truncate(time.Second).After(t)
+	}
+
+	return false
+}
+
+// authorName returns the name shown for the author of a blog. Atom requires a
+// non-empty name.
+func authorName(data *blogItem) string {
+	if data.AuthorID == "" {
+		return "Anonymous"
+	}
+
+	return data.AuthorID
+}
+
+func (h *feedHandler) rss(title string, selfURL string, blogs []*blogItem, lastModified time.Time) *rssFeed {
+	feed := &rssFeed{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		DCNS:    "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:       title,
+			Link:        h.siteURL + "/",
+			Description: title,
+			SelfLink: atomLink{
+				Href: selfURL,
+				Rel:  "self",
+				Type: "application/rss+xml",
+			},
+		},
+	}
+
+	if !lastModified.IsZero() {
+		feed.Channel.LastBuildDate = lastModified.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, data := range blogs {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       data.Title,
+			Link:        h.blogURL(data),
+			Description: data.Content,
+			Creator:     data.AuthorID,
+			Categories:  data.Tags,
+			GUID: rssGUID{
+				IsPermaLink: true,
+				Value:       h.blogURL(data),
+			},
+			PubDate: data.ID.Timestamp().UTC().Format(time.RFC1123Z),
+		})
+	}
+
+	return feed
+}
+
+func (h *feedHandler) atom(title string, selfURL string, blogs []*blogItem, lastModified time.Time) *atomFeed {
+	if lastModified.IsZero() {
+		// updated is required, use a fixed date so empty feeds keep their ETag.
+		lastModified = time.Unix(0, 0)
+	}
+
+	feed := &atomFeed{
+		Title:   title,
+		ID:      selfURL,
+		Updated: lastModified.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: selfURL, Rel: "self", Type: "application/atom+xml"},
+			{Href: h.siteURL + "/", Rel: "alternate", Type: "text/html"},
+		},
+	}
+
+	for _, data := range blogs {
+		entry := atomEntry{
+			Title:     data.Title,
+			ID:        h.blogURL(data),
+			Published: data.ID.Timestamp().UTC().Format(time.RFC3339),
+			Updated:   data.updatedAt().UTC().Format(time.RFC3339),
+			Author:    atomPerson{Name: authorName(data)},
+			Links: []atomLink{
+				{Href: h.blogURL(data), Rel: "alternate", Type: "text/html"},
+			},
+			Content: atomContent{
+				Type: "text",
+				Body: data.Content,
+			},
+		}
+
+		for _, tag := range data.Tags {
+			entry.Categories = append(entry.Categories, atomCategory{Term: tag})
+		}
+
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return feed
+}
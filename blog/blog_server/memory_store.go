@@ -0,0 +1,347 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryStore is a blogStore that keeps blogs in process memory. It is meant
+// for local development and loses all data when the server stops.
+type memoryStore struct {
+	mu    sync.RWMutex
+	blogs map[primitive.ObjectID]*blogItem
+	// reactions is keyed by blog, then by user and reaction type.
+	reactions map[primitive.ObjectID]map[memoryReaction]struct{}
+	// views is keyed by blog, then by bucket start.
+	views map[primitive.ObjectID]map[time.Time]int64
+}
+
+type memoryReaction struct {
+	userID   string
+	reaction string
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		blogs:     make(map[primitive.ObjectID]*blogItem),
+		reactions: make(map[primitive.ObjectID]map[memoryReaction]struct{}),
+		views:     make(map[primitive.ObjectID]map[time.Time]int64),
+	}
+}
+
+func (m *memoryStore) CreateBlog(ctx context.Context, data *blogItem) (primitive.ObjectID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item := copyBlogItem(data)
+
+	if item.ID.IsZero() {
+		item.ID = primitive.NewObjectID()
+	}
+
+	m.blogs[item.ID] = item
+
+	return item.ID, nil
+}
+
+func (m *memoryStore) ReadBlog(ctx context.Context, id primitive.ObjectID) (*blogItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	data, ok := m.blogs[id]
+
+	if !ok {
+		return nil, errBlogNotFound
+	}
+
+	return copyBlogItem(data), nil
+}
+
+func (m *memoryStore) ReadBlogs(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*blogItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	blogs := make(map[primitive.ObjectID]*blogItem, len(ids))
+
+	for _, id := range ids {
+		if data, ok := m.blogs[id]; ok {
+			blogs[id] = copyBlogItem(data)
+		}
+	}
+
+	return blogs, nil
+}
+
+func (m *memoryStore) UpdateBlog(ctx context.Context, data *blogItem) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.blogs[data.ID]
+
+	if !ok {
+		return errBlogNotFound
+	}
+
+	item := copyBlogItem(data)
+	item.ReactionCounts = current.ReactionCounts
+	item.Translations = current.Translations
+	item.Revision = current.Revision + 1
+
+	m.blogs[data.ID] = item
+
+	return nil
+}
+
+func (m *memoryStore) SetTranslation(ctx context.Context, data *blogItem, tag string, translation *blogTranslation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.blogs[data.ID]
+
+	if !ok {
+		return errBlogNotFound
+	}
+
+	if current.Revision != data.Revision {
+		return errBlogStatusChanged
+	}
+
+	item := copyBlogItem(current)
+
+	if translation != nil {
+		if item.Translations == nil {
+			item.Translations = make(map[string]blogTranslation)
+		}
+
+		item.Translations[tag] = *translation
+	} else {
+		delete(item.Translations, tag)
+	}
+
+	item.SchemaVersion = data.SchemaVersion
+	item.UpdatedAt = data.UpdatedAt
+	item.Status = data.Status
+	item.ModerationReasons = append([]string(nil), data.ModerationReasons...)
+	item.Revision = current.Revision + 1
+
+	m.blogs[data.ID] = item
+
+	return nil
+}
+
+func (m *memoryStore) DeleteBlog(ctx context.Context, id primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.blogs[id]; !ok {
+		return errBlogNotFound
+	}
+
+	delete(m.blogs, id)
+	delete(m.reactions, id)
+	delete(m.views, id)
+
+	return nil
+}
+
+func (m *memoryStore) ListBlogs(ctx context.Context, afterID primitive.ObjectID, fn func(data *blogItem) error) error {
+	// Take a snapshot so fn can call back into the store without deadlocking.
+	m.mu.RLock()
+
+	items := make([]*blogItem, 0, len(m.blogs))
+
+	for id, data := range m.blogs {
+		if afterID.IsZero() || bytes.Compare(id[:], afterID[:]) > 0 {
+			items = append(items, copyBlogItem(data))
+		}
+	}
+
+	m.mu.RUnlock()
+
+	sort.Slice(items, func(i, j int) bool {
+		return bytes.Compare(items[i].ID[:], items[j].ID[:]) < 0
+	})
+
+	for _, data := range items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := fn(data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryStore) LatestBlogs(ctx context.Context, authorID string, limit int) ([]*blogItem, error) {
+	m.mu.RLock()
+
+	blogs := []*blogItem{}
+
+	for _, data := range m.blogs {
+		if data.published() && (authorID == "" || data.AuthorID == authorID) {
+			blogs = append(blogs, copyBlogItem(data))
+		}
+	}
+
+	m.mu.RUnlock()
+
+	sort.Slice(blogs, func(i, j int) bool {
+		return bytes.Compare(blogs[i].ID[:], blogs[j].ID[:]) > 0
+	})
+
+	if len(blogs) > limit {
+		blogs = blogs[:limit]
+	}
+
+	return blogs, nil
+}
+
+func (m *memoryStore) BlogStats(ctx context.Context, from time.Time, to time.Time) (*blogStats, error) {
+	return aggregateBlogStats(ctx, m, from, to)
+}
+
+func (m *memoryStore) AddReaction(ctx context.Context, blogID primitive.ObjectID, userID string, reaction string) (map[string]int64, bool, error) {
+	return m.changeReaction(blogID, memoryReaction{userID: userID, reaction: reaction}, true)
+}
+
+func (m *memoryStore) RemoveReaction(ctx context.Context, blogID primitive.ObjectID, userID string, reaction string) (map[string]int64, bool, error) {
+	return m.changeReaction(blogID, memoryReaction{userID: userID, reaction: reaction}, false)
+}
+
+func (m *memoryStore) changeReaction(blogID primitive.ObjectID, r memoryReaction, add bool) (map[string]int64, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.blogs[blogID]
+
+	if !ok {
+		return nil, false, errBlogNotFound
+	}
+
+	reactions, ok := m.reactions[blogID]
+
+	if !ok {
+		reactions = make(map[memoryReaction]struct{})
+		m.reactions[blogID] = reactions
+	}
+
+	_, exists := reactions[r]
+	changed := exists != add
+
+	if changed {
+		if data.ReactionCounts == nil {
+			data.ReactionCounts = make(map[string]int64)
+		}
+
+		if add {
+			reactions[r] = struct{}{}
+			data.ReactionCounts[r.reaction]++
+		} else {
+			delete(reactions, r)
+			data.ReactionCounts[r.reaction]--
+		}
+	}
+
+	return copyCounts(data.ReactionCounts), changed, nil
+}
+
+func (m *memoryStore) RecordViews(ctx context.Context, counts []viewCount) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, c := range counts {
+		if _, ok := m.blogs[c.BlogID]; !ok {
+			continue
+		}
+
+		buckets, ok := m.views[c.BlogID]
+
+		if !ok {
+			buckets = make(map[time.Time]int64)
+			m.views[c.BlogID] = buckets
+		}
+
+		buckets[c.Bucket] += c.Count
+	}
+
+	return nil
+}
+
+func (m *memoryStore) TrendingBlogs(ctx context.Context, now time.Time, since time.Time, halfLife time.Duration, limit int) ([]trendingScore, error) {
+	m.mu.RLock()
+
+	counts := []viewCount{}
+
+	for blogID, buckets := range m.views {
+		if data, ok := m.blogs[blogID]; !ok || !data.published() {
+			continue
+		}
+
+		for bucket, count := range buckets {
+			counts = append(counts, viewCount{BlogID: blogID, Bucket: bucket, Count: count})
+		}
+	}
+
+	m.mu.RUnlock()
+
+	return aggregateTrending(counts, now, since, halfLife, limit), nil
+}
+
+func (m *memoryStore) BlogsWithStatus(ctx context.Context, status string, afterID primitive.ObjectID, limit int) ([]*blogItem, error) {
+	m.mu.RLock()
+
+	blogs := []*blogItem{}
+
+	for id, data := range m.blogs {
+		if data.Status == status && (afterID.IsZero() || bytes.Compare(id[:], afterID[:]) > 0) {
+			blogs = append(blogs, copyBlogItem(data))
+		}
+	}
+
+	m.mu.RUnlock()
+
+	sort.Slice(blogs, func(i, j int) bool {
+		return bytes.Compare(blogs[i].ID[:], blogs[j].ID[:]) < 0
+	})
+
+	if len(blogs) > limit {
+		blogs = blogs[:limit]
+	}
+
+	return blogs, nil
+}
+
+func (m *memoryStore) SetBlogStatus(ctx context.Context, id primitive.ObjectID, from string, revision int64, to string, reasons []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.blogs[id]
+
+	if !ok {
+		return errBlogNotFound
+	}
+
+	// Like publishedFilter, no status and published are the same.
+	matches := data.Status == from
+
+	if from == "" || from == blogStatusPublished {
+		matches = data.published()
+	}
+
+	if !matches || data.Revision != revision {
+		return errBlogStatusChanged
+	}
+
+	data.Status = to
+	data.ModerationReasons = append([]string(nil), reasons...)
+
+	return nil
+}
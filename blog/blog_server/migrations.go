@@ -0,0 +1,426 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// currentBlogSchemaVersion is written to every blog saved by this server.
+// Documents without it were written by an older CreateBlog.
+const currentBlogSchemaVersion = 1
+
+// migration is a versioned change to the data in MongoDB. Up and Down are
+// given the configured blog collection, other collections are reached through
+// its Database. They return the number of documents they changed or, in a dry
+// run, would change.
+type migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, blogs *mongo.Collection, opts migrateOptions) (int64, error)
+	Down        func(ctx context.Context, blogs *mongo.Collection, opts migrateOptions) (int64, error)
+}
+
+// migrateOptions are set by the flags of the migrate subcommand.
+type migrateOptions struct {
+	DryRun bool
+	// IDs lists the blogs the operator confirmed for a migration that cannot
+	// pick them itself, nil if no list was given.
+	IDs []primitive.ObjectID
+}
+
+// migrations must be kept in ascending version order and never renumbered
+// once released.
+var migrations = []migration{
+	{
+		Version:     1,
+		Description: "Swap title and content of the blogs listed in -ids-file",
+		Up:          swapTitleContentUp,
+		Down:        swapTitleContentDown,
+	},
+}
+
+// appliedMigration is stored in the migrations collection for every migration
+// that has been applied.
+type appliedMigration struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+	Affected    int64     `bson:"affected"`
+}
+
+// swappedMarker flags the blogs swapped by migration 1 so the rollback only
+// touches those. UpdateBlog removes it, as an edited blog holds the values
+// its author wants whatever the repair did.
+const swappedMarker = "title_content_repaired"
+
+// swapTitleContentUp repairs blogs written by the old CreateBlog, which stored
+// the title as content and the other way around. Such documents are among
+// the ones without a schema_version.
+//
+// Blogs rewritten by the old UpdateBlog also have no schema_version but
+// already hold the right values, and nothing stored in them tells the two
+// apart. So the migration does not guess: a dry run lists the candidates
+// with the start of their title and content, and the real run only swaps
+// the ones the operator copied into the -ids-file.
+func swapTitleContentUp(ctx context.Context, collection *mongo.Collection, opts migrateOptions) (int64, error) {
+	filter := bson.D{{Key: "schema_version", Value: bson.D{{Key: "$exists", Value: false}}}}
+
+	if opts.IDs != nil {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$in", Value: opts.IDs}}})
+	}
+
+	if opts.DryRun {
+		return printSwapCandidates(ctx, collection, filter)
+	}
+
+	if opts.IDs == nil {
+		return 0, fmt.Errorf("list the blogs to swap with -ids-file, a dry run prints the candidates (an empty file swaps none)")
+	}
+
+	// The $set stage sees the original document, so both fields are swapped
+	// in a single atomic update per document.
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "title", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$content", ""}}}},
+			{Key: "content", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$title", ""}}}},
+			{Key: "schema_version", Value: currentBlogSchemaVersion},
+			{Key: swappedMarker, Value: true},
+		}}},
+	}
+
+	res, err := collection.UpdateMany(ctx, filter, update)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if skipped := int64(len(opts.IDs)) - res.MatchedCount; skipped > 0 {
+		fmt.Printf("%d listed blogs were skipped, they do not exist or have a schema_version\n", skipped)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+func swapTitleContentDown(ctx context.Context, collection *mongo.Collection, opts migrateOptions) (int64, error) {
+	filter := bson.D{{Key: swappedMarker, Value: true}}
+
+	if opts.DryRun {
+		return printSwapCandidates(ctx, collection, filter)
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "title", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$content", ""}}}},
+			{Key: "content", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$title", ""}}}},
+		}}},
+		{{Key: "$unset", Value: bson.A{"schema_version", swappedMarker}}},
+	}
+
+	res, err := collection.UpdateMany(ctx, filter, update)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// printSwapCandidates prints one line per matching blog in the format read
+// by readIDsFile, with the start of its stored title and content as a
+// comment, so the lines to keep can be copied into an -ids-file.
+func printSwapCandidates(ctx context.Context, collection *mongo.Collection, filter bson.D) (int64, error) {
+	opts := options.Find().SetProjection(bson.D{
+		{Key: "_id", Value: 1},
+		{Key: "title", Value: 1},
+		{Key: "content", Value: 1},
+	})
+
+	cur, err := collection.Find(ctx, filter, opts)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer cur.Close(ctx)
+
+	count := int64(0)
+
+	for cur.Next(ctx) {
+		doc := struct {
+			ID      primitive.ObjectID `bson:"_id"`
+			Title   string             `bson:"title"`
+			Content string             `bson:"content"`
+		}{}
+
+		if err := cur.Decode(&doc); err != nil {
+			return count, err
+		}
+
+		count++
+		fmt.Printf("%v # stored title %q, stored content %q\n", doc.ID.Hex(), excerpt(doc.Title), excerpt(doc.Content))
+	}
+
+	return count, cur.Err()
+}
+
+// excerpt shortens text to its first 40 characters.
+func excerpt(text string) string {
+	runes := []rune(text)
+
+	if len(runes) <= 40 {
+		return text
+	}
+
+	return string(runes[:40]) + "..."
+}
+
+// readIDsFile reads blog IDs, one per line. Anything after a # is a comment,
+// blank lines are skipped.
+func readIDsFile(path string) ([]primitive.ObjectID, error) {
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []primitive.ObjectID{}
+
+	for i, line := range strings.Split(string(content), "\n") {
+		if comment := strings.Index(line, "#"); comment >= 0 {
+			line = line[:comment]
+		}
+
+		line = strings.TrimSpace(line)
+
+		if line == "" {
+			continue
+		}
+
+		id, err := primitive.ObjectIDFromHex(line)
+
+		if err != nil {
+			return nil, fmt.Errorf("%v:%d: cannot parse ID: %v", path, i+1, line)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func appliedMigrations(ctx context.Context, db *mongo.Database) (map[int]appliedMigration, error) {
+	cur, err := db.Collection("migrations").Find(ctx, bson.D{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	records := []appliedMigration{}
+
+	if err := cur.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]appliedMigration, len(records))
+
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+
+	return applied, nil
+}
+
+// pendingMigrations returns how many known migrations have not been applied.
+func pendingMigrations(ctx context.Context, db *mongo.Database) (int, error) {
+	applied, err := appliedMigrations(ctx, db)
+
+	if err != nil {
+		return 0, err
+	}
+
+	pending := 0
+
+	for _, m := range migrations {
+		if _, ok := applied[m.Version]; !ok {
+			pending++
+		}
+	}
+
+	return pending, nil
+}
+
+// runMigrate implements the migrate subcommand:
+//
+//	blog_server migrate [-dry-run] [-to version] [-ids-file path] status|up|down
+//
+// up applies pending migrations up to and including -to (default: all).
+// down rolls back applied migrations newer than -to (default: the latest one).
+// -ids-file lists the blogs confirmed for migration 1, see swapTitleContentUp.
+func runMigrate(ctx context.Context, blogs *mongo.Collection, args []string) error {
+	db := blogs.Database()
+
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Print what would change without writing anything")
+	to := fs.Int("to", -1, "Target version")
+	idsFile := fs.String("ids-file", "", "File listing the IDs of the blogs confirmed for migration 1, one per line")
+
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: blog_server migrate [-dry-run] [-to version] [-ids-file path] status|up|down")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("expected exactly one command")
+	}
+
+	opts := migrateOptions{DryRun: *dryRun}
+
+	if *idsFile != "" {
+		ids, err := readIDsFile(*idsFile)
+
+		if err != nil {
+			return err
+		}
+
+		opts.IDs = ids
+	}
+
+	applied, err := appliedMigrations(ctx, db)
+
+	if err != nil {
+		return err
+	}
+
+	switch fs.Arg(0) {
+	case "status":
+		for _, m := range migrations {
+			state := "pending"
+
+			if record, ok := applied[m.Version]; ok {
+				state = fmt.Sprintf("applied %v (%d documents)", record.AppliedAt.Format(time.RFC3339), record.Affected)
+			}
+
+			fmt.Printf("%4d  %-40s  %s\n", m.Version, state, m.Description)
+		}
+
+		return nil
+	case "up":
+		return migrateUp(ctx, blogs, applied, *to, opts)
+	case "down":
+		return migrateDown(ctx, blogs, applied, *to, opts)
+	default:
+		fs.Usage()
+		return fmt.Errorf("unknown command: %v", fs.Arg(0))
+	}
+}
+
+func migrateUp(ctx context.Context, blogs *mongo.Collection, applied map[int]appliedMigration, to int, opts migrateOptions) error {
+	for _, m := range migrations {
+		if to >= 0 && m.Version > to {
+			break
+		}
+
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+
+		fmt.Printf("Applying migration %d: %s\n", m.Version, m.Description)
+
+		affected, err := m.Up(ctx, blogs, opts)
+
+		if err != nil {
+			return fmt.Errorf("migration %d failed: %v", m.Version, err)
+		}
+
+		if opts.DryRun {
+			fmt.Printf("Migration %d would change %d documents\n", m.Version, affected)
+			continue
+		}
+
+		record := appliedMigration{
+			Version:     m.Version,
+			Description: m.Description,
+			AppliedAt:   time.Now().UTC(),
+			Affected:    affected,
+		}
+
+		if _, err := blogs.Database().Collection("migrations").InsertOne(ctx, record); err != nil {
+			return fmt.Errorf("recording migration %d failed: %v", m.Version, err)
+		}
+
+		fmt.Printf("Migration %d changed %d documents\n", m.Version, affected)
+	}
+
+	return nil
+}
+
+func migrateDown(ctx context.Context, blogs *mongo.Collection, applied map[int]appliedMigration, to int, opts migrateOptions) error {
+	versions := []int{}
+
+	for version := range applied {
+		versions = append(versions, version)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+	if to < 0 {
+		// Only roll back the latest migration by default.
+		if len(versions) == 0 {
+			return nil
+		}
+
+		to = versions[0] - 1
+	}
+
+	byVersion := make(map[int]migration, len(migrations))
+
+	for _, m := range migrations {
+		byVersion[m.Version] = m
+	}
+
+	for _, version := range versions {
+		if version <= to {
+			break
+		}
+
+		m, ok := byVersion[version]
+
+		if !ok {
+			return fmt.Errorf("migration %d is applied but unknown to this server", version)
+		}
+
+		fmt.Printf("Rolling back migration %d: %s\n", m.Version, m.Description)
+
+		affected, err := m.Down(ctx, blogs, opts)
+
+		if err != nil {
+			return fmt.Errorf("rolling back migration %d failed: %v", m.Version, err)
+		}
+
+		if opts.DryRun {
+			fmt.Printf("Rolling back migration %d would change %d documents\n", m.Version, affected)
+			continue
+		}
+
+		if _, err := blogs.Database().Collection("migrations").DeleteOne(ctx, bson.D{{Key: "_id", Value: m.Version}}); err != nil {
+			return fmt.Errorf("removing record of migration %d failed: %v", m.Version, err)
+		}
+
+		fmt.Printf("Rolled back migration %d, changed %d documents\n", m.Version, affected)
+	}
+
+	return nil
+}
@@ -0,0 +1,555 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	blogStatusPublished   = "published"
+	blogStatusQuarantined = "quarantined"
+	blogStatusRejected    = "rejected"
+
+	defaultModerationLimit = 20
+	maxModerationLimit     = 100
+)
+
+var blogStatusNames = map[blogpb.BlogStatus]string{
+	blogpb.BlogStatus_BLOG_STATUS_PUBLISHED:   blogStatusPublished,
+	blogpb.BlogStatus_BLOG_STATUS_QUARANTINED: blogStatusQuarantined,
+	blogpb.BlogStatus_BLOG_STATUS_REJECTED:    blogStatusRejected,
+}
+
+func blogStatusToPb(name string) blogpb.BlogStatus {
+	if name == "" {
+		return blogpb.BlogStatus_BLOG_STATUS_PUBLISHED
+	}
+
+	for blogStatus, statusName := range blogStatusNames {
+		if statusName == name {
+			return blogStatus
+		}
+	}
+
+	return blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED
+}
+
+// errBlogStatusChanged is returned by blogStore.SetBlogStatus and
+// SetTranslation when the blog no longer has the expected status or was
+// updated since it was read.
+var errBlogStatusChanged = errors.New("blog status changed")
+
+// moderationFilter inspects a blog before it is saved. It returns the reasons
+// to hold the blog for review, or none if it may be published.
+type moderationFilter interface {
+	Check(data *blogItem) []string
+}
+
+// moderationChain runs every filter and collects all their reasons, so that
+// moderators see everything that is wrong with a blog at once.
+type moderationChain []moderationFilter
+
+func (c moderationChain) Check(data *blogItem) []string {
+	reasons := []string{}
+
+	for _, filter := range c {
+		reasons = append(reasons, filter.Check(data)...)
+	}
+
+	return reasons
+}
+
+// moderatedText is the text of a blog that filters inspect, including its
+// translations.
+func moderatedText(data *blogItem) string {
+	parts := []string{data.Title, data.Content, strings.Join(data.Tags, "\n")}
+
+	for _, translation := range data.Translations {
+		parts = append(parts, translation.Title, translation.Content)
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+// bannedTermsFilter holds blogs containing any of a list of terms. Terms match
+// case-insensitively and only as whole words, so "ass" does not match "class".
+type bannedTermsFilter struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+func newBannedTermsFilter(name string, terms []string) *bannedTermsFilter {
+	quoted := []string{}
+
+	for _, term := range terms {
+		if term = strings.TrimSpace(term); term != "" {
+			quoted = append(quoted, regexp.QuoteMeta(term))
+		}
+	}
+
+	if len(quoted) == 0 {
+		return nil
+	}
+
+	return &bannedTermsFilter{
+		name:    name,
+		pattern: regexp.MustCompile(`(?i)(?:^|[^\pL\pN])(` + strings.Join(quoted, "|") + `)(?:$|[^\pL\pN])`),
+	}
+}
+
+func (f *bannedTermsFilter) Check(data *blogItem) []string {
+	found := map[string]bool{}
+	reasons := []string{}
+
+	text := moderatedText(data)
+
+	// Matches consume the character after a term, so search again from the
+	// start of each term to find terms separated by a single character.
+	for offset := 0; offset < len(text); {
+		loc := f.pattern.FindStringSubmatchIndex(text[offset:])
+
+		if loc == nil {
+			break
+		}
+
+		term := strings.ToLower(text[offset+loc[2] : offset+loc[3]])
+
+		if !found[term] {
+			found[term] = true
+			reasons = append(reasons, fmt.Sprintf("contains banned term %q (%v)", term, f.name))
+		}
+
+		offset += loc[3]
+	}
+
+	return reasons
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// linkLimitFilter holds blogs with more than max links, a common sign of spam.
+type linkLimitFilter struct {
+	max int
+}
+
+func (f *linkLimitFilter) Check(data *blogItem) []string {
+	links := len(linkPattern.FindAllStringIndex(moderatedText(data), -1))
+
+	if links > f.max {
+		return []string{fmt.Sprintf("contains %d links, at most %d are allowed", links, f.max)}
+	}
+
+	return nil
+}
+
+// regexFilter holds blogs matching a regular expression.
+type regexFilter struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+func (f *regexFilter) Check(data *blogItem) []string {
+	if f.pattern.MatchString(moderatedText(data)) {
+		return []string{fmt.Sprintf("matches rule %q", f.name)}
+	}
+
+	return nil
+}
+
+// moderationConfig is the JSON file given with the moderation-config flag:
+//
+//	{
+//	  "banned_terms": [
+//	    {"name": "profanity", "file": "profanity.txt"},
+//	    {"name": "spam", "terms": ["buy now", "free money"]}
+//	  ],
+//	  "max_links": 3,
+//	  "rules": [
+//	    {"name": "phone number", "pattern": "\\+?[0-9][0-9 -]{8,}[0-9]"}
+//	  ]
+//	}
+//
+// Term files hold one term per line, lines starting with # are ignored.
+// Relative file paths are resolved against the directory of the config file.
+type moderationConfig struct {
+	BannedTerms []struct {
+		Name  string   `json:"name"`
+		File  string   `json:"file"`
+		Terms []string `json:"terms"`
+	} `json:"banned_terms"`
+	// MaxLinks disables the link limit when absent.
+	MaxLinks *int `json:"max_links"`
+	Rules    []struct {
+		Name    string `json:"name"`
+		Pattern string `json:"pattern"`
+	} `json:"rules"`
+}
+
+// loadModeration builds the filter chain described by a moderationConfig file.
+// An empty path yields a chain that publishes every blog.
+func loadModeration(path string) (moderationChain, error) {
+	chain := moderationChain{}
+
+	if path == "" {
+		return chain, nil
+	}
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	config := &moderationConfig{}
+
+	if err := json.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("parsing %v: %v", path, err)
+	}
+
+	for i, list := range config.BannedTerms {
+		name := list.Name
+
+		if name == "" {
+			name = fmt.Sprintf("list %d", i+1)
+		}
+
+		terms := list.Terms
+
+		if list.File != "" {
+			file := list.File
+
+			if !filepath.IsAbs(file) {
+				file = filepath.Join(filepath.Dir(path), file)
+			}
+
+			fileTerms, err := readTermsFile(file)
+
+			if err != nil {
+				return nil, err
+			}
+
+			terms = append(terms, fileTerms...)
+		}
+
+		if filter := newBannedTermsFilter(name, terms); filter != nil {
+			chain = append(chain, filter)
+		}
+	}
+
+	if config.MaxLinks != nil {
+		if *config.MaxLinks < 0 {
+			return nil, fmt.Errorf("max_links cannot be negative")
+		}
+
+		chain = append(chain, &linkLimitFilter{max: *config.MaxLinks})
+	}
+
+	for i, rule := range config.Rules {
+		pattern, err := regexp.Compile(rule.Pattern)
+
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %v", i+1, err)
+		}
+
+		name := rule.Name
+
+		if name == "" {
+			name = rule.Pattern
+		}
+
+		chain = append(chain, &regexFilter{name: name, pattern: pattern})
+	}
+
+	return chain, nil
+}
+
+func readTermsFile(path string) ([]string, error) {
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	terms := []string{}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			terms = append(terms, line)
+		}
+	}
+
+	return terms, nil
+}
+
+// moderate runs the filters over a blog that is about to be saved and sets its
+// status. A quarantined or rejected blog stays in review however it is edited,
+// keeping its earlier reasons, since only ApproveBlog may publish it.
+func (s *server) moderate(data *blogItem) {
+	held := data.Status == blogStatusQuarantined || data.Status == blogStatusRejected
+	found := s.moderation.Check(data)
+
+	if held {
+		found = append(append([]string(nil), data.ModerationReasons...), found...)
+	}
+
+	if data.Status == blogStatusRejected {
+		found = append(found, "edited after being rejected")
+	}
+
+	reasons := []string{}
+	seen := map[string]bool{}
+
+	for _, reason := range found {
+		if !seen[reason] {
+			seen[reason] = true
+			reasons = append(reasons, reason)
+		}
+	}
+
+	data.Status = blogStatusPublished
+	data.ModerationReasons = nil
+
+	if held || len(reasons) > 0 {
+		data.Status = blogStatusQuarantined
+		data.ModerationReasons = reasons
+	}
+}
+
+// prepareWrite queues the webhook event, if any, of a write that leaves data
+// in its state, depending on whether readers could see the blog before.
+func (s *server) prepareWrite(data *blogItem, wasPublished bool) (string, error) {
+	switch {
+	case data.published() && wasPublished:
+		return s.prepareEvent(blogUpdatedEvent, data)
+	case data.published():
+		return s.prepareEvent(blogCreatedEvent, data)
+	case wasPublished:
+		return s.prepareEvent(blogDeletedEvent, &blogItem{ID: data.ID})
+	}
+
+	return "", nil
+}
+
+// written updates the related blogs index and releases the webhook event of a
+// blog that has just been saved.
+func (s *server) written(data *blogItem, wasPublished bool, eventID string) {
+	switch {
+	case data.published():
+		s.related.Index(data)
+	case wasPublished:
+		s.related.Remove(data.ID)
+	}
+
+	s.confirmEvent(eventID)
+}
+
+// moderationServer implements ModerationService on top of the blog server.
+// Every call must be made by one of moderators.
+type moderationServer struct {
+	blogpb.ModerationServiceServer
+
+	blogs      *server
+	auth       *authenticator
+	moderators userSet
+}
+
+func (m *moderationServer) ListQuarantinedBlogs(ctx context.Context, req *blogpb.ListQuarantinedBlogsRequest) (*blogpb.ListQuarantinedBlogsResponse, error) {
+
+	fmt.Printf("List quarantined blogs request: %v\n", req)
+
+	if _, err := m.auth.Authorize(ctx, m.moderators); err != nil {
+		return nil, err
+	}
+
+	afterID := primitive.NilObjectID
+
+	if req.GetAfterId() != "" {
+		oid, err := primitive.ObjectIDFromHex(req.GetAfterId())
+
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Cannot parse after ID: %v", req.GetAfterId()),
+			)
+		}
+
+		afterID = oid
+	}
+
+	limit := int(req.GetLimit())
+
+	if limit < 0 || limit > maxModerationLimit {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Limit must be between 0 and %d", maxModerationLimit),
+		)
+	}
+
+	if limit == 0 {
+		limit = defaultModerationLimit
+	}
+
+	blogs, err := m.blogs.store.BlogsWithStatus(ctx, blogStatusQuarantined, afterID, limit)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &blogpb.ListQuarantinedBlogsResponse{}
+
+	for _, data := range blogs {
+		resp.Blogs = append(resp.Blogs, blogItemToPb(data))
+	}
+
+	return resp, nil
+}
+
+func (m *moderationServer) ApproveBlog(ctx context.Context, req *blogpb.ApproveBlogRequest) (*blogpb.ApproveBlogResponse, error) {
+
+	fmt.Printf("Approve blog request: %v\n", req)
+
+	if _, err := m.auth.Authorize(ctx, m.moderators); err != nil {
+		return nil, err
+	}
+
+	data, err := m.review(ctx, req.GetBlogId(), func(data *blogItem) error {
+		if data.Status != blogStatusQuarantined && data.Status != blogStatusRejected {
+			return status.Errorf(
+				codes.FailedPrecondition,
+				"Only quarantined or rejected blogs can be approved",
+			)
+		}
+
+		data.Status = blogStatusPublished
+		data.ModerationReasons = nil
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.blogs.rememberTitle(ctx, data)
+
+	resp := &blogpb.ApproveBlogResponse{
+		Blog: blogItemToPb(data),
+	}
+
+	return resp, nil
+}
+
+func (m *moderationServer) RejectBlog(ctx context.Context, req *blogpb.RejectBlogRequest) (*blogpb.RejectBlogResponse, error) {
+
+	fmt.Printf("Reject blog request: %v\n", req)
+
+	if _, err := m.auth.Authorize(ctx, m.moderators); err != nil {
+		return nil, err
+	}
+
+	data, err := m.review(ctx, req.GetBlogId(), func(data *blogItem) error {
+		if data.Status != blogStatusQuarantined {
+			return status.Errorf(
+				codes.FailedPrecondition,
+				"Only quarantined blogs can be rejected",
+			)
+		}
+
+		data.Status = blogStatusRejected
+
+		if reason := strings.TrimSpace(req.GetReason()); reason != "" {
+			data.ModerationReasons = append(data.ModerationReasons, "rejected: "+reason)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.RejectBlogResponse{
+		Blog: blogItemToPb(data),
+	}
+
+	return resp, nil
+}
+
+// review reads a blog, passes it to decide to change its status and saves
+// the new status unless the blog changed in the meantime, so that a
+// moderator never decides on content they have not seen.
+func (m *moderationServer) review(ctx context.Context, blogID string, decide func(data *blogItem) error) (*blogItem, error) {
+	oid, err := primitive.ObjectIDFromHex(blogID)
+
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot parse ID",
+		)
+	}
+
+	data, err := m.blogs.store.ReadBlog(ctx, oid)
+
+	if err == errBlogNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	previous := data.Status
+	wasPublished := data.published()
+
+	if err := decide(data); err != nil {
+		return nil, err
+	}
+
+	eventID, err := m.blogs.prepareWrite(data, wasPublished)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	err = m.blogs.store.SetBlogStatus(ctx, oid, previous, data.Revision, data.Status, data.ModerationReasons)
+
+	if err != nil {
+		m.blogs.abandonEvent(eventID)
+	}
+
+	if err == errBlogNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
+		)
+	}
+
+	if err == errBlogStatusChanged {
+		return nil, status.Errorf(
+			codes.Aborted,
+			"Blog was changed concurrently, try again",
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	m.blogs.written(data, wasPublished, eventID)
+
+	return data, nil
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testAuthSecret = "test secret"
+
+// newTestServer returns a blog server on memory stores whose filter holds
+// blogs mentioning spam.
+func newTestServer() *server {
+	store := newMemoryStore()
+
+	return &server{
+		store:      store,
+		related:    newRelatedIndex(),
+		webhooks:   newWebhookDispatcher(newMemoryWebhookStore(), store),
+		reactions:  newReactionHub(),
+		views:      newViewCounter(store),
+		moderation: moderationChain{&regexFilter{name: "spam", pattern: regexp.MustCompile("spam")}},
+		series:     newMemorySeriesStore(),
+		bookmarks:  newMemoryReadingListStore(),
+		auth:       newAuthenticator(testAuthSecret),
+	}
+}
+
+// asUser returns a context carrying a token for userID, as the gRPC server
+// would pass it to a handler.
+func asUser(userID string) context.Context {
+	encode := base64.RawURLEncoding.EncodeToString
+	unsigned := encode([]byte(`{"alg":"HS256"}`)) + "." +
+		encode([]byte(fmt.Sprintf(`{"sub":%q,"exp":%d}`, userID, time.Now().Add(time.Hour).Unix())))
+
+	mac := hmac.New(sha256.New, []byte(testAuthSecret))
+	mac.Write([]byte(unsigned))
+
+	token := unsigned + "." + encode(mac.Sum(nil))
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func updateTestBlog(s *server, blogID string, content string) error {
+	_, err := s.UpdateBlog(asUser("author"), &blogpb.UpdateBlogRequest{
+		Blog: &blogpb.Blog{Id: blogID, Title: "title", Content: content},
+	})
+
+	return err
+}
+
+func TestModerationHoldsReviewedBlogsUntilApproved(t *testing.T) {
+	tests := []struct {
+		name        string
+		reject      bool
+		edits       []func(s *server, blogID string) error
+		wantReasons []string
+	}{
+		{
+			name: "quarantined then edited",
+			edits: []func(s *server, blogID string) error{
+				func(s *server, blogID string) error { return updateTestBlog(s, blogID, "clean") },
+			},
+			wantReasons: []string{`matches rule "spam"`},
+		},
+		{
+			name:   "rejected then edited twice",
+			reject: true,
+			edits: []func(s *server, blogID string) error{
+				func(s *server, blogID string) error { return updateTestBlog(s, blogID, "clean") },
+				func(s *server, blogID string) error { return updateTestBlog(s, blogID, "still clean") },
+			},
+			wantReasons: []string{`matches rule "spam"`, "rejected: off topic", "edited after being rejected"},
+		},
+		{
+			name:   "rejected then edited and translated",
+			reject: true,
+			edits: []func(s *server, blogID string) error{
+				func(s *server, blogID string) error { return updateTestBlog(s, blogID, "clean") },
+				func(s *server, blogID string) error {
+					_, err := s.AddTranslation(asUser("author"), &blogpb.AddTranslationRequest{
+						BlogId:      blogID,
+						Translation: &blogpb.Translation{Language: "de", Title: "Titel", Content: "sauber"},
+					})
+
+					return err
+				},
+			},
+			wantReasons: []string{`matches rule "spam"`, "rejected: off topic", "edited after being rejected"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer()
+			m := &moderationServer{blogs: s, auth: s.auth, moderators: userSet{"moderator": true}}
+
+			created, err := s.CreateBlog(asUser("author"), &blogpb.CreateBlogRequest{
+				Blog: &blogpb.Blog{Title: "title", Content: "spam"},
+			})
+
+			if err != nil {
+				t.Fatalf("CreateBlog: %v", err)
+			}
+
+			blogID := created.GetBlog().GetId()
+
+			if tt.reject {
+				_, err := m.RejectBlog(asUser("moderator"), &blogpb.RejectBlogRequest{BlogId: blogID, Reason: "off topic"})
+
+				if err != nil {
+					t.Fatalf("RejectBlog: %v", err)
+				}
+			}
+
+			for i, edit := range tt.edits {
+				if err := edit(s, blogID); err != nil {
+					t.Fatalf("edit %d: %v", i+1, err)
+				}
+			}
+
+			_, err = s.ReadBlog(context.Background(), &blogpb.ReadBlogRequest{BlogId: blogID})
+
+			if status.Code(err) != codes.NotFound {
+				t.Errorf("ReadBlog: got %v, want NotFound", err)
+			}
+
+			oid, _ := primitive.ObjectIDFromHex(blogID)
+
+			data, err := s.store.ReadBlog(context.Background(), oid)
+
+			if err != nil {
+				t.Fatalf("store.ReadBlog: %v", err)
+			}
+
+			if data.Status != blogStatusQuarantined || fmt.Sprint(data.ModerationReasons) != fmt.Sprint(tt.wantReasons) {
+				t.Errorf("got %v %q, want %v %q", data.Status, data.ModerationReasons, blogStatusQuarantined, tt.wantReasons)
+			}
+
+			if _, err := m.ApproveBlog(asUser("moderator"), &blogpb.ApproveBlogRequest{BlogId: blogID}); err != nil {
+				t.Fatalf("ApproveBlog: %v", err)
+			}
+
+			if _, err := s.ReadBlog(context.Background(), &blogpb.ReadBlogRequest{BlogId: blogID}); err != nil {
+				t.Errorf("ReadBlog after approval: got %v, want the blog", err)
+			}
+		})
+	}
+}
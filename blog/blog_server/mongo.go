@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMongoURI = "mongodb://localhost:27017"
+
+	mongoInitialBackoff = 500 * time.Millisecond
+	mongoMaxBackoff     = 15 * time.Second
+)
+
+// mongoConfig holds the settings of the mongo store backend.
+type mongoConfig struct {
+	URI      string
+	Database string
+	// Collection holds the blogs. The other stores use fixed collections in
+	// the same database.
+	Collection string
+	// ConnectTimeout bounds opening a connection and finding a server for an
+	// operation, after which the operation fails as unavailable.
+	ConnectTimeout time.Duration
+	// OperationTimeout bounds every read and write on a connection.
+	OperationTimeout time.Duration
+	// StartupTimeout is how long connecting is retried before the server
+	// gives up.
+	StartupTimeout time.Duration
+	// PingInterval is how often the connection is checked, zero to disable
+	// the checks.
+	PingInterval time.Duration
+}
+
+func (c mongoConfig) clientOptions() *options.ClientOptions {
+	return options.Client().
+		ApplyURI(c.URI).
+		SetConnectTimeout(c.ConnectTimeout).
+		SetServerSelectionTimeout(c.ConnectTimeout).
+		SetSocketTimeout(c.OperationTimeout)
+}
+
+// connectMongo returns a client once the server answers a ping. Failed pings
+// are retried with exponential backoff until StartupTimeout has passed, so the
+// blog server can be started before MongoDB is up.
+func connectMongo(cfg mongoConfig) (*mongo.Client, error) {
+	client, err := mongo.NewClient(cfg.clientOptions())
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.StartupTimeout)
+
+	defer cancel()
+
+	if err := client.Connect(ctx); err != nil {
+		return nil, err
+	}
+
+	backoff := mongoInitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := pingMongo(ctx, client, cfg.ConnectTimeout)
+
+		if err == nil {
+			return client, nil
+		}
+
+		fmt.Printf("MongoDB is not reachable (attempt %d), retrying in %v: %v\n", attempt, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			client.Disconnect(context.Background())
+
+			return nil, fmt.Errorf("gave up after %d attempts: %w", attempt, err)
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+
+		if backoff > mongoMaxBackoff {
+			backoff = mongoMaxBackoff
+		}
+	}
+}
+
+func pingMongo(ctx context.Context, client *mongo.Client, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	defer cancel()
+
+	return client.Ping(ctx, readpref.Primary())
+}
+
+// monitorMongo pings the server every interval until ctx is done and logs when
+// it becomes unreachable or reachable again. The driver reconnects on its own;
+// in between, store calls fail with Unavailable.
+func monitorMongo(ctx context.Context, client *mongo.Client, interval time.Duration, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+
+	defer ticker.Stop()
+
+	reachable := true
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := pingMongo(ctx, client, timeout)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		switch {
+		case err != nil && reachable:
+			fmt.Printf("MongoDB became unreachable: %v\n", err)
+		case err == nil && !reachable:
+			fmt.Println("MongoDB is reachable again")
+		}
+
+		reachable = err == nil
+	}
+}
+
+// mongoUnavailable reports whether err means that MongoDB could not be
+// reached, as opposed to rejecting the operation.
+func mongoUnavailable(err error) bool {
+	var selection topology.ServerSelectionError
+
+	return errors.As(err, &selection) ||
+		errors.Is(err, mongo.ErrClientDisconnected) ||
+		mongo.IsNetworkError(err) ||
+		mongo.IsTimeout(err)
+}
+
+// storeError converts an unexpected store error to a status. Errors caused by
+// the database being unreachable are Unavailable, so callers know they can
+// retry, everything else is Internal.
+func storeError(err error) error {
+	if mongoUnavailable(err) {
+		return status.Errorf(
+			codes.Unavailable,
+			fmt.Sprintf("Database unavailable: %v", err),
+		)
+	}
+
+	return status.Errorf(
+		codes.Internal,
+		fmt.Sprintf("Internal error: %v", err),
+	)
+}
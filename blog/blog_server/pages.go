@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// pageTemplate renders the HTML pages that feed links point to.
+var pageTemplate = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<link rel="alternate" type="application/atom+xml" href="{{.SiteURL}}/feeds/atom.xml">
+</head>
+<body>
+{{- if .Blog}}
+<article>
+<h1>{{.Blog.Title}}</h1>
+<p>By {{.Author}}, {{.Published}}</p>
+{{- range .Paragraphs}}
+<p>{{.}}</p>
+{{- end}}
+{{- if .Blog.Tags}}
+<p>Tags: {{range $i, $tag := .Blog.Tags}}{{if $i}}, {{end}}{{$tag}}{{end}}</p>
+{{- end}}
+</article>
+<p><a href="{{.SiteURL}}/">All blogs</a></p>
+{{- else}}
+<h1>{{.Title}}</h1>
+<ul>
+{{- range .Blogs}}
+<li><a href="{{$.SiteURL}}/blogs/{{.ID.Hex}}">{{.Title}}</a></li>
+{{- end}}
+</ul>
+{{- end}}
+</body>
+</html>
+`))
+
+type pageData struct {
+	Title      string
+	SiteURL    string
+	Blog       *blogItem
+	Author     string
+	Published  string
+	Paragraphs []string
+	Blogs      []*blogItem
+}
+
+// pageHandler serves the pages that feed entries link to:
+//
+//	/                the latest blogs
+//	/blogs/<blog id> a published blog in its original language
+//
+// Anything else, including blogs held by moderation, is not found.
+type pageHandler struct {
+	store    blogStore
+	siteURL  string
+	maxItems int
+}
+
+func newPageHandler(store blogStore, siteURL string, maxItems int) *pageHandler {
+	return &pageHandler{
+		store:    store,
+		siteURL:  strings.TrimSuffix(siteURL, "/"),
+		maxItems: maxItems,
+	}
+}
+
+func (h *pageHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	page := &pageData{Title: "Blog", SiteURL: h.siteURL}
+
+	var err error
+
+	switch path := r.URL.Path; {
+	case path == "/":
+		page.Blogs, err = h.store.LatestBlogs(r.Context(), "", h.maxItems)
+	case strings.HasPrefix(path, "/blogs/"):
+		oid, parseErr := primitive.ObjectIDFromHex(strings.TrimPrefix(path, "/blogs/"))
+
+		if parseErr != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		page.Blog, err = h.store.ReadBlog(r.Context(), oid)
+
+		if err == errBlogNotFound || err == nil && !page.Blog.published() {
+			http.NotFound(w, r)
+			return
+		}
+
+		if err == nil {
+			page.Title = page.Blog.Title
+			page.Author = authorName(page.Blog)
+			page.Published = page.Blog.ID.Timestamp().UTC().Format("2 January 2006")
+			page.Paragraphs = paragraphs(page.Blog.Content)
+		}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Failed to load blogs for page: %v\n", err)
+
+		if mongoUnavailable(err) {
+			http.Error(w, "database unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	var body bytes.Buffer
+
+	if err := pageTemplate.Execute(&body, page); err != nil {
+		fmt.Printf("Failed to render page: %v\n", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(body.Len()))
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method != http.MethodHead {
+		w.Write(body.Bytes())
+	}
+}
+
+// paragraphs splits blog content into the paragraphs separated by blank
+// lines.
+func paragraphs(content string) []string {
+	result := []string{}
+
+	for _, paragraph := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			result = append(result, paragraph)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPageHandler(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+
+	published, err := store.CreateBlog(ctx, &blogItem{AuthorID: "alice", Title: "Hello <world>", Content: "first\n\nsecond"})
+
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+
+	held, err := store.CreateBlog(ctx, &blogItem{AuthorID: "bob", Title: "Held", Content: "spam", Status: blogStatusQuarantined})
+
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+
+	tests := []struct {
+		path     string
+		code     int
+		contains []string
+		excludes []string
+	}{
+		{path: "/blogs/" + published.Hex(), code: http.StatusOK, contains: []string{"Hello &lt;world&gt;", "<p>first</p>", "<p>second</p>", "By alice"}},
+		{path: "/blogs/" + held.Hex(), code: http.StatusNotFound},
+		{path: "/blogs/not-an-id", code: http.StatusNotFound},
+		{path: "/", code: http.StatusOK, contains: []string{"https://example.com/blogs/" + published.Hex()}, excludes: []string{held.Hex()}},
+		{path: "/other", code: http.StatusNotFound},
+	}
+
+	handler := newPageHandler(store, "https://example.com/", 10)
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if rec.Code != tt.code {
+				t.Fatalf("got status %d, want %d", rec.Code, tt.code)
+			}
+
+			body := rec.Body.String()
+
+			for _, want := range tt.contains {
+				if !strings.Contains(body, want) {
+					t.Errorf("got %q, want it to contain %q", body, want)
+				}
+			}
+
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(body, unwanted) {
+					t.Errorf("got %q, want it not to contain %q", body, unwanted)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reactionNames maps the supported reaction types to the names they are stored
+// under.
+var reactionNames = map[blogpb.ReactionType]string{
+	blogpb.ReactionType_REACTION_TYPE_LIKE:  "like",
+	blogpb.ReactionType_REACTION_TYPE_LOVE:  "love",
+	blogpb.ReactionType_REACTION_TYPE_LAUGH: "laugh",
+	blogpb.ReactionType_REACTION_TYPE_WOW:   "wow",
+	blogpb.ReactionType_REACTION_TYPE_SAD:   "sad",
+	blogpb.ReactionType_REACTION_TYPE_ANGRY: "angry",
+}
+
+// watchReactionsInterval is the minimum time between two messages sent to a
+// WatchReactions stream. Changes within the interval are coalesced.
+const watchReactionsInterval = 100 * time.Millisecond
+
+// reactionCountsToPb converts stored counts to the API representation, in
+// enum order and without zero counts.
+func reactionCountsToPb(counts map[string]int64) []*blogpb.ReactionCount {
+	result := []*blogpb.ReactionCount{}
+
+	for t := blogpb.ReactionType_REACTION_TYPE_LIKE; t <= blogpb.ReactionType_REACTION_TYPE_ANGRY; t++ {
+		if count := counts[reactionNames[t]]; count > 0 {
+			result = append(result, &blogpb.ReactionCount{
+				Type:  t,
+				Count: count,
+			})
+		}
+	}
+
+	return result
+}
+
+func equalCounts(a map[string]int64, b map[string]int64) bool {
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+
+	for k, v := range b {
+		if a[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reactionHub tells WatchReactions streams that the reactions of a blog have
+// changed. It only carries a signal: watchers read the counts from the store,
+// so out of order notifications cannot leave them with stale counts. Only
+// changes made through this server are signalled.
+type reactionHub struct {
+	mu       sync.Mutex
+	watchers map[primitive.ObjectID]map[chan struct{}]struct{}
+}
+
+func newReactionHub() *reactionHub {
+	return &reactionHub{
+		watchers: make(map[primitive.ObjectID]map[chan struct{}]struct{}),
+	}
+}
+
+// Watch returns a channel that receives a value after the reactions of the
+// blog have changed. Several changes before the value is received collapse
+// into one.
+func (h *reactionHub) Watch(blogID primitive.ObjectID) chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+
+	if _, ok := h.watchers[blogID]; !ok {
+		h.watchers[blogID] = make(map[chan struct{}]struct{})
+	}
+
+	h.watchers[blogID][ch] = struct{}{}
+
+	return ch
+}
+
+func (h *reactionHub) Unwatch(blogID primitive.ObjectID, ch chan struct{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.watchers[blogID], ch)
+
+	if len(h.watchers[blogID]) == 0 {
+		delete(h.watchers, blogID)
+	}
+}
+
+func (h *reactionHub) Notify(blogID primitive.ObjectID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.watchers[blogID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// A change is already pending for this watcher.
+		}
+	}
+}
+
+func parseReactionRequest(blogID string, reactionType blogpb.ReactionType) (primitive.ObjectID, string, error) {
+	oid, err := primitive.ObjectIDFromHex(blogID)
+
+	if err != nil {
+		return oid, "", status.Errorf(
+			codes.InvalidArgument,
+			"Cannot parse ID",
+		)
+	}
+
+	reaction, ok := reactionNames[reactionType]
+
+	if !ok {
+		return oid, "", status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Unknown reaction type: %v", reactionType),
+		)
+	}
+
+	return oid, reaction, nil
+}
+
+// reactionUser returns the authenticated caller, who can only react as
+// themselves.
+func (s *server) reactionUser(ctx context.Context, requested string) (string, error) {
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	if requested != "" && requested != userID {
+		return "", status.Errorf(
+			codes.PermissionDenied,
+			fmt.Sprintf("User %v cannot react as %v", userID, requested),
+		)
+	}
+
+	return userID, nil
+}
+
+func (s *server) ReactToBlog(ctx context.Context, req *blogpb.ReactToBlogRequest) (*blogpb.ReactToBlogResponse, error) {
+
+	fmt.Printf("React to blog request: %v\n", req)
+
+	userID, err := s.reactionUser(ctx, req.GetUserId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	oid, reaction, err := parseReactionRequest(req.GetBlogId(), req.GetType())
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts, changed, err := s.store.AddReaction(ctx, oid, userID, reaction)
+
+	if err == errBlogNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", req.GetBlogId()),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	if changed {
+		s.reactions.Notify(oid)
+	}
+
+	resp := &blogpb.ReactToBlogResponse{
+		Reactions: reactionCountsToPb(counts),
+	}
+
+	return resp, nil
+}
+
+func (s *server) RemoveReaction(ctx context.Context, req *blogpb.RemoveReactionRequest) (*blogpb.RemoveReactionResponse, error) {
+
+	fmt.Printf("Remove reaction request: %v\n", req)
+
+	userID, err := s.reactionUser(ctx, req.GetUserId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	oid, reaction, err := parseReactionRequest(req.GetBlogId(), req.GetType())
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts, changed, err := s.store.RemoveReaction(ctx, oid, userID, reaction)
+
+	if err == errBlogNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", req.GetBlogId()),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	if changed {
+		s.reactions.Notify(oid)
+	}
+
+	resp := &blogpb.RemoveReactionResponse{
+		Reactions: reactionCountsToPb(counts),
+	}
+
+	return resp, nil
+}
+
+func (s *server) WatchReactions(req *blogpb.WatchReactionsRequest, stream blogpb.BlogService_WatchReactionsServer) error {
+
+	fmt.Printf("Watch reactions request: %v\n", req)
+
+	blogID := req.GetBlogId()
+
+	oid, err := primitive.ObjectIDFromHex(blogID)
+
+	if err != nil {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Cannot parse ID",
+		)
+	}
+
+	ctx := stream.Context()
+
+	// Watch before the first read so no change can slip in between.
+	changes := s.reactions.Watch(oid)
+	defer s.reactions.Unwatch(oid, changes)
+
+	var sent map[string]int64
+
+	for {
+		data, err := s.store.ReadBlog(ctx, oid)
+
+		if err == errBlogNotFound {
+			return status.Errorf(
+				codes.NotFound,
+				fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
+			)
+		}
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+
+		if err != nil {
+			return storeError(err)
+		}
+
+		if sent == nil || !equalCounts(sent, data.ReactionCounts) {
+			resp := &blogpb.WatchReactionsResponse{
+				BlogId:    blogID,
+				Reactions: reactionCountsToPb(data.ReactionCounts),
+			}
+
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+
+			sent = copyCounts(data.ReactionCounts)
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-changes:
+		}
+
+		// Let further changes accumulate before reading again.
+		timer := time.NewTimer(watchReactionsInterval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,550 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	errReadingListNotFound = errors.New("reading list not found")
+	errReadingListExists   = errors.New("reading list already exists")
+	errBookmarkNotFound    = errors.New("bookmark not found")
+	errBookmarkExists      = errors.New("bookmark already exists")
+)
+
+type readingListItem struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    string             `bson:"user_id"`
+	Name      string             `bson:"name"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// bookmarkItem is a blog bookmarked by a user. It outlives the blog so that
+// deleted blogs show up as tombstones.
+type bookmarkItem struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	UserID string             `bson:"user_id"`
+	BlogID primitive.ObjectID `bson:"blog_id"`
+	ListID primitive.ObjectID `bson:"list_id,omitempty"`
+	Read   bool               `bson:"read"`
+	ReadAt time.Time          `bson:"read_at,omitempty"`
+	// Title is the title of the blog when it was last seen.
+	Title     string    `bson:"title"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// bookmarkUpdate holds the changes to a bookmark, nil fields are left as they
+// are.
+type bookmarkUpdate struct {
+	// ListID takes the bookmark out of its list when it is the nil ObjectID.
+	ListID *primitive.ObjectID
+	Read   *bool
+	// ReadAt is stored when Read is true.
+	ReadAt time.Time
+}
+
+type bookmarkQuery struct {
+	UserID string
+	// ListID matches every list when it is the nil ObjectID.
+	ListID primitive.ObjectID
+	// Read matches read and unread bookmarks when nil.
+	Read *bool
+	// BeforeID continues a previous query unless it is the nil ObjectID.
+	BeforeID primitive.ObjectID
+	Limit    int
+}
+
+// readingListStore persists the reading lists and bookmarks of users. Every
+// method but SetBookmarkTitles is scoped to one user, lists and bookmarks of
+// other users behave as if they did not exist.
+type readingListStore interface {
+	// CreateList returns errReadingListExists if the user has a list with
+	// the same name.
+	CreateList(ctx context.Context, data *readingListItem) (primitive.ObjectID, error)
+	// ListLists returns the lists of a user sorted by name.
+	ListLists(ctx context.Context, userID string) ([]*readingListItem, error)
+	ReadList(ctx context.Context, userID string, id primitive.ObjectID) (*readingListItem, error)
+	// DeleteList removes a list and takes its bookmarks out of it.
+	DeleteList(ctx context.Context, userID string, id primitive.ObjectID) error
+
+	// AddBookmark returns errBookmarkExists if the user has bookmarked the
+	// blog already.
+	AddBookmark(ctx context.Context, data *bookmarkItem) (primitive.ObjectID, error)
+	UpdateBookmark(ctx context.Context, userID string, blogID primitive.ObjectID, update bookmarkUpdate) (*bookmarkItem, error)
+	RemoveBookmark(ctx context.Context, userID string, blogID primitive.ObjectID) error
+	// ListBookmarks returns up to query.Limit bookmarks, most recently
+	// created first.
+	ListBookmarks(ctx context.Context, query bookmarkQuery) ([]*bookmarkItem, error)
+	// SetBookmarkTitles changes the title remembered by every bookmark of a
+	// blog, of all users.
+	SetBookmarkTitles(ctx context.Context, blogID primitive.ObjectID, title string) error
+}
+
+// mongoReadingListStore is a readingListStore backed by MongoDB collections.
+type mongoReadingListStore struct {
+	lists     *mongo.Collection
+	bookmarks *mongo.Collection
+}
+
+func newMongoReadingListStore(db *mongo.Database) *mongoReadingListStore {
+	return &mongoReadingListStore{
+		lists:     db.Collection("reading_lists"),
+		bookmarks: db.Collection("bookmarks"),
+	}
+}
+
+// EnsureIndexes creates the indexes the store relies on.
+func (m *mongoReadingListStore) EnsureIndexes(ctx context.Context) error {
+	_, err := m.lists.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "name", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = m.bookmarks.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "blog_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "list_id", Value: 1},
+				{Key: "_id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{{Key: "blog_id", Value: 1}},
+		},
+	})
+
+	return err
+}
+
+func userFilter(userID string, key string, value interface{}) bson.D {
+	return bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: key, Value: value},
+	}
+}
+
+func (m *mongoReadingListStore) CreateList(ctx context.Context, data *readingListItem) (primitive.ObjectID, error) {
+	res, err := m.lists.InsertOne(ctx, data)
+
+	if mongo.IsDuplicateKeyError(err) {
+		return primitive.NilObjectID, errReadingListExists
+	}
+
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+
+	if !ok {
+		return primitive.NilObjectID, errors.New("cannot convert to OID")
+	}
+
+	return oid, nil
+}
+
+func (m *mongoReadingListStore) ListLists(ctx context.Context, userID string) ([]*readingListItem, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cur, err := m.lists.Find(ctx, bson.D{{Key: "user_id", Value: userID}}, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lists := []*readingListItem{}
+
+	if err := cur.All(ctx, &lists); err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+func (m *mongoReadingListStore) ReadList(ctx context.Context, userID string, id primitive.ObjectID) (*readingListItem, error) {
+	data := &readingListItem{}
+
+	err := m.lists.FindOne(ctx, userFilter(userID, "_id", id)).Decode(data)
+
+	if err == mongo.ErrNoDocuments {
+		return nil, errReadingListNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (m *mongoReadingListStore) DeleteList(ctx context.Context, userID string, id primitive.ObjectID) error {
+	res, err := m.lists.DeleteOne(ctx, userFilter(userID, "_id", id))
+
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return errReadingListNotFound
+	}
+
+	update := bson.D{{Key: "$unset", Value: bson.D{{Key: "list_id", Value: ""}}}}
+
+	_, err = m.bookmarks.UpdateMany(ctx, userFilter(userID, "list_id", id), update)
+
+	return err
+}
+
+func (m *mongoReadingListStore) AddBookmark(ctx context.Context, data *bookmarkItem) (primitive.ObjectID, error) {
+	res, err := m.bookmarks.InsertOne(ctx, data)
+
+	if mongo.IsDuplicateKeyError(err) {
+		return primitive.NilObjectID, errBookmarkExists
+	}
+
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+
+	if !ok {
+		return primitive.NilObjectID, errors.New("cannot convert to OID")
+	}
+
+	return oid, nil
+}
+
+func (m *mongoReadingListStore) SetBookmarkTitles(ctx context.Context, blogID primitive.ObjectID, title string) error {
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "title", Value: title}}}}
+
+	_, err := m.bookmarks.UpdateMany(ctx, bson.D{{Key: "blog_id", Value: blogID}}, update)
+
+	return err
+}
+
+func (m *mongoReadingListStore) UpdateBookmark(ctx context.Context, userID string, blogID primitive.ObjectID, update bookmarkUpdate) (*bookmarkItem, error) {
+	set := bson.D{}
+	unset := bson.D{}
+
+	if update.ListID != nil {
+		if update.ListID.IsZero() {
+			unset = append(unset, bson.E{Key: "list_id", Value: ""})
+		} else {
+			set = append(set, bson.E{Key: "list_id", Value: *update.ListID})
+		}
+	}
+
+	if update.Read != nil {
+		set = append(set, bson.E{Key: "read", Value: *update.Read})
+
+		if *update.Read {
+			set = append(set, bson.E{Key: "read_at", Value: update.ReadAt})
+		} else {
+			unset = append(unset, bson.E{Key: "read_at", Value: ""})
+		}
+	}
+
+	changes := bson.D{}
+
+	if len(set) > 0 {
+		changes = append(changes, bson.E{Key: "$set", Value: set})
+	}
+
+	if len(unset) > 0 {
+		changes = append(changes, bson.E{Key: "$unset", Value: unset})
+	}
+
+	filter := userFilter(userID, "blog_id", blogID)
+	data := &bookmarkItem{}
+
+	var err error
+
+	if len(changes) == 0 {
+		err = m.bookmarks.FindOne(ctx, filter).Decode(data)
+	} else {
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+		err = m.bookmarks.FindOneAndUpdate(ctx, filter, changes, opts).Decode(data)
+	}
+
+	if err == mongo.ErrNoDocuments {
+		return nil, errBookmarkNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (m *mongoReadingListStore) RemoveBookmark(ctx context.Context, userID string, blogID primitive.ObjectID) error {
+	res, err := m.bookmarks.DeleteOne(ctx, userFilter(userID, "blog_id", blogID))
+
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return errBookmarkNotFound
+	}
+
+	return nil
+}
+
+func (m *mongoReadingListStore) ListBookmarks(ctx context.Context, query bookmarkQuery) ([]*bookmarkItem, error) {
+	filter := bson.D{{Key: "user_id", Value: query.UserID}}
+
+	if !query.ListID.IsZero() {
+		filter = append(filter, bson.E{Key: "list_id", Value: query.ListID})
+	}
+
+	if query.Read != nil {
+		filter = append(filter, bson.E{Key: "read", Value: *query.Read})
+	}
+
+	if !query.BeforeID.IsZero() {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$lt", Value: query.BeforeID}}})
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(query.Limit))
+
+	cur, err := m.bookmarks.Find(ctx, filter, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bookmarks := []*bookmarkItem{}
+
+	if err := cur.All(ctx, &bookmarks); err != nil {
+		return nil, err
+	}
+
+	return bookmarks, nil
+}
+
+// memoryReadingListStore is a readingListStore kept in process memory.
+type memoryReadingListStore struct {
+	mu        sync.Mutex
+	lists     map[primitive.ObjectID]*readingListItem
+	bookmarks map[primitive.ObjectID]*bookmarkItem
+}
+
+func newMemoryReadingListStore() *memoryReadingListStore {
+	return &memoryReadingListStore{
+		lists:     make(map[primitive.ObjectID]*readingListItem),
+		bookmarks: make(map[primitive.ObjectID]*bookmarkItem),
+	}
+}
+
+func (m *memoryReadingListStore) CreateList(ctx context.Context, data *readingListItem) (primitive.ObjectID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, list := range m.lists {
+		if list.UserID == data.UserID && list.Name == data.Name {
+			return primitive.NilObjectID, errReadingListExists
+		}
+	}
+
+	item := *data
+	item.ID = primitive.NewObjectID()
+
+	m.lists[item.ID] = &item
+
+	return item.ID, nil
+}
+
+func (m *memoryReadingListStore) ListLists(ctx context.Context, userID string) ([]*readingListItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	lists := []*readingListItem{}
+
+	for _, data := range m.lists {
+		if data.UserID == userID {
+			item := *data
+			lists = append(lists, &item)
+		}
+	}
+
+	sort.Slice(lists, func(i, j int) bool {
+		return lists[i].Name < lists[j].Name
+	})
+
+	return lists, nil
+}
+
+func (m *memoryReadingListStore) ReadList(ctx context.Context, userID string, id primitive.ObjectID) (*readingListItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.lists[id]
+
+	if !ok || data.UserID != userID {
+		return nil, errReadingListNotFound
+	}
+
+	item := *data
+
+	return &item, nil
+}
+
+func (m *memoryReadingListStore) DeleteList(ctx context.Context, userID string, id primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.lists[id]
+
+	if !ok || data.UserID != userID {
+		return errReadingListNotFound
+	}
+
+	delete(m.lists, id)
+
+	for _, bookmark := range m.bookmarks {
+		if bookmark.UserID == userID && bookmark.ListID == id {
+			bookmark.ListID = primitive.NilObjectID
+		}
+	}
+
+	return nil
+}
+
+// findBookmark must be called with m.mu held.
+func (m *memoryReadingListStore) findBookmark(userID string, blogID primitive.ObjectID) *bookmarkItem {
+	for _, bookmark := range m.bookmarks {
+		if bookmark.UserID == userID && bookmark.BlogID == blogID {
+			return bookmark
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryReadingListStore) AddBookmark(ctx context.Context, data *bookmarkItem) (primitive.ObjectID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.findBookmark(data.UserID, data.BlogID) != nil {
+		return primitive.NilObjectID, errBookmarkExists
+	}
+
+	item := *data
+	item.ID = primitive.NewObjectID()
+
+	m.bookmarks[item.ID] = &item
+
+	return item.ID, nil
+}
+
+func (m *memoryReadingListStore) SetBookmarkTitles(ctx context.Context, blogID primitive.ObjectID, title string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, bookmark := range m.bookmarks {
+		if bookmark.BlogID == blogID {
+			bookmark.Title = title
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryReadingListStore) UpdateBookmark(ctx context.Context, userID string, blogID primitive.ObjectID, update bookmarkUpdate) (*bookmarkItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	bookmark := m.findBookmark(userID, blogID)
+
+	if bookmark == nil {
+		return nil, errBookmarkNotFound
+	}
+
+	if update.ListID != nil {
+		bookmark.ListID = *update.ListID
+	}
+
+	if update.Read != nil {
+		bookmark.Read = *update.Read
+		bookmark.ReadAt = time.Time{}
+
+		if *update.Read {
+			bookmark.ReadAt = update.ReadAt
+		}
+	}
+
+	item := *bookmark
+
+	return &item, nil
+}
+
+func (m *memoryReadingListStore) RemoveBookmark(ctx context.Context, userID string, blogID primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	bookmark := m.findBookmark(userID, blogID)
+
+	if bookmark == nil {
+		return errBookmarkNotFound
+	}
+
+	delete(m.bookmarks, bookmark.ID)
+
+	return nil
+}
+
+func (m *memoryReadingListStore) ListBookmarks(ctx context.Context, query bookmarkQuery) ([]*bookmarkItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	bookmarks := []*bookmarkItem{}
+
+	for id, data := range m.bookmarks {
+		if data.UserID != query.UserID ||
+			(!query.ListID.IsZero() && data.ListID != query.ListID) ||
+			(query.Read != nil && data.Read != *query.Read) ||
+			(!query.BeforeID.IsZero() && bytes.Compare(id[:], query.BeforeID[:]) >= 0) {
+			continue
+		}
+
+		item := *data
+		bookmarks = append(bookmarks, &item)
+	}
+
+	sort.Slice(bookmarks, func(i, j int) bool {
+		return bytes.Compare(bookmarks[i].ID[:], bookmarks[j].ID[:]) > 0
+	})
+
+	if len(bookmarks) > query.Limit {
+		bookmarks = bookmarks[:query.Limit]
+	}
+
+	return bookmarks, nil
+}
@@ -0,0 +1,551 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultBookmarksPageSize = 20
+	maxBookmarksPageSize     = 100
+	maxReadingListName       = 100
+
+	// hiddenBookmarkTitle replaces the title of a blog held by moderation,
+	// which must not show on its bookmarks.
+	hiddenBookmarkTitle = "Unavailable blog"
+)
+
+// readingListServer implements ReadingListService. Every call is made on
+// behalf of the user authenticated by auth.
+type readingListServer struct {
+	blogpb.ReadingListServiceServer
+
+	store readingListStore
+	blogs blogStore
+	auth  *authenticator
+}
+
+func readingListItemToPb(data *readingListItem) *blogpb.ReadingList {
+	return &blogpb.ReadingList{
+		Id:        data.ID.Hex(),
+		Name:      data.Name,
+		CreatedAt: timestamppb.New(data.CreatedAt),
+	}
+}
+
+// bookmarkToPb embeds the current title of the bookmarked blog, nil if it is
+// gone, localized for the caller. Bookmarks of deleted blogs keep the title
+// remembered by the store, the last one the blog was published with.
+func bookmarkToPb(data *bookmarkItem, blog *blogItem, prefs []language.Tag) *blogpb.Bookmark {
+	bookmark := &blogpb.Bookmark{
+		BlogId:    data.BlogID.Hex(),
+		Read:      data.Read,
+		CreatedAt: timestamppb.New(data.CreatedAt),
+		Title:     data.Title,
+	}
+
+	if !data.ListID.IsZero() {
+		bookmark.ListId = data.ListID.Hex()
+	}
+
+	if data.Read {
+		bookmark.ReadAt = timestamppb.New(data.ReadAt)
+	}
+
+	switch {
+	case blog == nil:
+		bookmark.State = blogpb.BookmarkState_BOOKMARK_STATE_DELETED
+	case !blog.published():
+		bookmark.State = blogpb.BookmarkState_BOOKMARK_STATE_HIDDEN
+		bookmark.Title = hiddenBookmarkTitle
+	default:
+		bookmark.State = blogpb.BookmarkState_BOOKMARK_STATE_AVAILABLE
+		bookmark.Title = localize(blog, prefs).GetTitle()
+	}
+
+	return bookmark
+}
+
+// rememberTitle makes the bookmarks of a blog remember its title once it is
+// published. A stale title only shows on the tombstone, so a failure is
+// logged.
+func (s *server) rememberTitle(ctx context.Context, data *blogItem) {
+	if !data.published() {
+		return
+	}
+
+	if err := s.bookmarks.SetBookmarkTitles(ctx, data.ID, data.Title); err != nil {
+		fmt.Printf("Failed to update bookmark titles of blog %v: %v\n", data.ID.Hex(), err)
+	}
+}
+
+// bookmarkResponse converts a bookmark returned by the store, or maps the
+// store's error to a status.
+func (s *readingListServer) bookmarkResponse(ctx context.Context, data *bookmarkItem, err error, blogID string) (*blogpb.Bookmark, error) {
+	if err == errBookmarkNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Blog %s is not bookmarked", blogID),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	prefs, err := localePreferences(ctx, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := s.blogs.ReadBlog(ctx, data.BlogID)
+
+	if err == errBlogNotFound {
+		blog, err = nil, nil
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return bookmarkToPb(data, blog, prefs), nil
+}
+
+func parseBlogID(blogID string) (primitive.ObjectID, error) {
+	oid, err := primitive.ObjectIDFromHex(blogID)
+
+	if err != nil {
+		return oid, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot parse blog ID",
+		)
+	}
+
+	return oid, nil
+}
+
+// readList parses a list ID and checks that the list belongs to the user.
+func (s *readingListServer) readList(ctx context.Context, userID string, listID string) (primitive.ObjectID, error) {
+	oid, err := primitive.ObjectIDFromHex(listID)
+
+	if err != nil {
+		return oid, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot parse list ID",
+		)
+	}
+
+	_, err = s.store.ReadList(ctx, userID, oid)
+
+	if err == errReadingListNotFound {
+		return oid, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find reading list with specified ID: %s", listID),
+		)
+	}
+
+	if err != nil {
+		return oid, storeError(err)
+	}
+
+	return oid, nil
+}
+
+func (s *readingListServer) CreateReadingList(ctx context.Context, req *blogpb.CreateReadingListRequest) (*blogpb.CreateReadingListResponse, error) {
+
+	fmt.Printf("Create reading list request: %v\n", req)
+
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.GetName())
+
+	if name == "" || len([]rune(name)) > maxReadingListName {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Name must be between 1 and %d characters", maxReadingListName),
+		)
+	}
+
+	data := &readingListItem{
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	oid, err := s.store.CreateList(ctx, data)
+
+	if err == errReadingListExists {
+		return nil, status.Errorf(
+			codes.AlreadyExists,
+			fmt.Sprintf("A reading list named %q already exists", name),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	data.ID = oid
+
+	resp := &blogpb.CreateReadingListResponse{
+		List: readingListItemToPb(data),
+	}
+
+	return resp, nil
+}
+
+func (s *readingListServer) ListReadingLists(ctx context.Context, req *blogpb.ListReadingListsRequest) (*blogpb.ListReadingListsResponse, error) {
+
+	fmt.Printf("List reading lists request: %v\n", req)
+
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := s.store.ListLists(ctx, userID)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &blogpb.ListReadingListsResponse{}
+
+	for _, data := range lists {
+		resp.Lists = append(resp.Lists, readingListItemToPb(data))
+	}
+
+	return resp, nil
+}
+
+func (s *readingListServer) DeleteReadingList(ctx context.Context, req *blogpb.DeleteReadingListRequest) (*blogpb.DeleteReadingListResponse, error) {
+
+	fmt.Printf("Delete reading list request: %v\n", req)
+
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listID := req.GetListId()
+
+	oid, err := primitive.ObjectIDFromHex(listID)
+
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot parse list ID",
+		)
+	}
+
+	err = s.store.DeleteList(ctx, userID, oid)
+
+	if err == errReadingListNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find reading list with specified ID: %s", listID),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &blogpb.DeleteReadingListResponse{
+		ListId: listID,
+	}
+
+	return resp, nil
+}
+
+func (s *readingListServer) AddBookmark(ctx context.Context, req *blogpb.AddBookmarkRequest) (*blogpb.AddBookmarkResponse, error) {
+
+	fmt.Printf("Add bookmark request: %v\n", req)
+
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blogID, err := parseBlogID(req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	listID := primitive.NilObjectID
+
+	if req.GetListId() != "" {
+		if listID, err = s.readList(ctx, userID, req.GetListId()); err != nil {
+			return nil, err
+		}
+	}
+
+	blog, err := s.blogs.ReadBlog(ctx, blogID)
+
+	if err == errBlogNotFound || (err == nil && !blog.published()) {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", req.GetBlogId()),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	data := &bookmarkItem{
+		UserID:    userID,
+		BlogID:    blogID,
+		ListID:    listID,
+		Title:     blog.Title,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	oid, err := s.store.AddBookmark(ctx, data)
+
+	if err == errBookmarkExists {
+		return nil, status.Errorf(
+			codes.AlreadyExists,
+			fmt.Sprintf("Blog %s is bookmarked already", req.GetBlogId()),
+		)
+	}
+
+	data.ID = oid
+
+	bookmark, err := s.bookmarkResponse(ctx, data, err, req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.AddBookmarkResponse{
+		Bookmark: bookmark,
+	}
+
+	return resp, nil
+}
+
+func (s *readingListServer) MoveBookmark(ctx context.Context, req *blogpb.MoveBookmarkRequest) (*blogpb.MoveBookmarkResponse, error) {
+
+	fmt.Printf("Move bookmark request: %v\n", req)
+
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blogID, err := parseBlogID(req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	listID := primitive.NilObjectID
+
+	if req.GetListId() != "" {
+		if listID, err = s.readList(ctx, userID, req.GetListId()); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := s.store.UpdateBookmark(ctx, userID, blogID, bookmarkUpdate{ListID: &listID})
+
+	bookmark, err := s.bookmarkResponse(ctx, data, err, req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.MoveBookmarkResponse{
+		Bookmark: bookmark,
+	}
+
+	return resp, nil
+}
+
+func (s *readingListServer) SetBookmarkRead(ctx context.Context, req *blogpb.SetBookmarkReadRequest) (*blogpb.SetBookmarkReadResponse, error) {
+
+	fmt.Printf("Set bookmark read request: %v\n", req)
+
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blogID, err := parseBlogID(req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	read := req.GetRead()
+
+	update := bookmarkUpdate{
+		Read:   &read,
+		ReadAt: time.Now().UTC(),
+	}
+
+	data, err := s.store.UpdateBookmark(ctx, userID, blogID, update)
+
+	bookmark, err := s.bookmarkResponse(ctx, data, err, req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.SetBookmarkReadResponse{
+		Bookmark: bookmark,
+	}
+
+	return resp, nil
+}
+
+func (s *readingListServer) RemoveBookmark(ctx context.Context, req *blogpb.RemoveBookmarkRequest) (*blogpb.RemoveBookmarkResponse, error) {
+
+	fmt.Printf("Remove bookmark request: %v\n", req)
+
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blogID, err := parseBlogID(req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.RemoveBookmark(ctx, userID, blogID)
+
+	if err == errBookmarkNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Blog %s is not bookmarked", req.GetBlogId()),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &blogpb.RemoveBookmarkResponse{
+		BlogId: req.GetBlogId(),
+	}
+
+	return resp, nil
+}
+
+func (s *readingListServer) ListBookmarks(ctx context.Context, req *blogpb.ListBookmarksRequest) (*blogpb.ListBookmarksResponse, error) {
+
+	fmt.Printf("List bookmarks request: %v\n", req)
+
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	query := bookmarkQuery{
+		UserID: userID,
+		Limit:  int(req.GetPageSize()),
+	}
+
+	if query.Limit < 0 || query.Limit > maxBookmarksPageSize {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Page size must be between 0 and %d", maxBookmarksPageSize),
+		)
+	}
+
+	if query.Limit == 0 {
+		query.Limit = defaultBookmarksPageSize
+	}
+
+	if req.GetListId() != "" {
+		if query.ListID, err = s.readList(ctx, userID, req.GetListId()); err != nil {
+			return nil, err
+		}
+	}
+
+	switch req.GetReadFilter() {
+	case blogpb.ReadFilter_READ_FILTER_ANY:
+	case blogpb.ReadFilter_READ_FILTER_READ:
+		read := true
+		query.Read = &read
+	case blogpb.ReadFilter_READ_FILTER_UNREAD:
+		read := false
+		query.Read = &read
+	default:
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Unknown read filter: %v", req.GetReadFilter()),
+		)
+	}
+
+	// The page token is the ID of the last bookmark of the previous page.
+	if token := req.GetPageToken(); token != "" {
+		if query.BeforeID, err = primitive.ObjectIDFromHex(token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"Invalid page token",
+			)
+		}
+	}
+
+	prefs, err := localePreferences(ctx, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	bookmarks, err := s.store.ListBookmarks(ctx, query)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	blogIDs := make([]primitive.ObjectID, len(bookmarks))
+
+	for i, data := range bookmarks {
+		blogIDs[i] = data.BlogID
+	}
+
+	blogs, err := s.blogs.ReadBlogs(ctx, blogIDs)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &blogpb.ListBookmarksResponse{}
+
+	for _, data := range bookmarks {
+		resp.Bookmarks = append(resp.Bookmarks, bookmarkToPb(data, blogs[data.BlogID], prefs))
+	}
+
+	if len(bookmarks) == query.Limit {
+		resp.NextPageToken = bookmarks[len(bookmarks)-1].ID.Hex()
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"container/heap"
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// titleWeight and tagWeight boost title words and tags over content words.
+	titleWeight = 2
+	tagWeight   = 3
+	// tagPrefix keeps tag terms apart from words of the same spelling.
+	tagPrefix = "#"
+	// maxCandidateDF is the document frequency ratio above which a term is too
+	// common to be used for finding candidates. Such terms still count towards
+	// the similarity of candidates found through rarer terms.
+	maxCandidateDF = 0.5
+	// normRefreshRatio is how much the number of blogs may change before the
+	// cached norms, which depend on the IDFs, are computed again. In between
+	// they are based on slightly outdated IDFs.
+	normRefreshRatio = 0.1
+)
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "but": true, "by": true, "for": true, "from": true, "has": true,
+	"have": true, "he": true, "in": true, "is": true, "it": true, "its": true,
+	"of": true, "on": true, "or": true, "she": true, "that": true, "the": true,
+	"their": true, "they": true, "this": true, "to": true, "was": true,
+	"we": true, "were": true, "will": true, "with": true, "you": true,
+}
+
+// relatedIndex keeps TF-IDF term vectors of every blog so similar posts can be
+// found without scanning the whole store. It is updated incrementally as blogs
+// are created, updated and deleted.
+type relatedIndex struct {
+	mu sync.RWMutex
+	// terms holds the term frequencies of each indexed blog.
+	terms map[primitive.ObjectID]map[string]float64
+	// postings maps a term to the blogs containing it.
+	postings map[string]map[primitive.ObjectID]struct{}
+	// norms caches the vector length of each indexed blog, computed when
+	// the blog is indexed or when normsDocs drifts too far from the number
+	// of blogs.
+	norms     map[primitive.ObjectID]float64
+	normsDocs int
+}
+
+type relatedResult struct {
+	ID    primitive.ObjectID
+	Score float64
+}
+
+func newRelatedIndex() *relatedIndex {
+	return &relatedIndex{
+		terms:    make(map[primitive.ObjectID]map[string]float64),
+		postings: make(map[string]map[primitive.ObjectID]struct{}),
+		norms:    make(map[primitive.ObjectID]float64),
+	}
+}
+
+// Load indexes every published blog currently in the store.
+func (r *relatedIndex) Load(ctx context.Context, store blogStore) error {
+	return store.ListBlogs(ctx, primitive.NilObjectID, func(data *blogItem) error {
+		if data.published() {
+			r.Index(data)
+		}
+
+		return nil
+	})
+}
+
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsNumber(c)
+	})
+
+	tokens := words[:0]
+
+	for _, word := range words {
+		if len([]rune(word)) > 1 && !stopWords[word] {
+			tokens = append(tokens, word)
+		}
+	}
+
+	return tokens
+}
+
+func termFrequencies(data *blogItem) map[string]float64 {
+	tf := make(map[string]float64)
+
+	for _, term := range tokenize(data.Title) {
+		tf[term] += titleWeight
+	}
+
+	for _, term := range tokenize(data.Content) {
+		tf[term]++
+	}
+
+	for _, tag := range data.Tags {
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+			tf[tagPrefix+tag] += tagWeight
+		}
+	}
+
+	return tf
+}
+
+// Index adds a blog to the index or replaces its previous vector.
+func (r *relatedIndex) Index(data *blogItem) {
+	tf := termFrequencies(data)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.removeLocked(data.ID)
+
+	r.terms[data.ID] = tf
+
+	for term := range tf {
+		docs, ok := r.postings[term]
+
+		if !ok {
+			docs = make(map[primitive.ObjectID]struct{})
+			r.postings[term] = docs
+		}
+
+		docs[data.ID] = struct{}{}
+	}
+
+	r.norms[data.ID] = r.norm(tf)
+	r.refreshNormsLocked()
+}
+
+// Remove drops a blog from the index.
+func (r *relatedIndex) Remove(id primitive.ObjectID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.removeLocked(id)
+	r.refreshNormsLocked()
+}
+
+func (r *relatedIndex) removeLocked(id primitive.ObjectID) {
+	tf, ok := r.terms[id]
+
+	if !ok {
+		return
+	}
+
+	for term := range tf {
+		docs := r.postings[term]
+		delete(docs, id)
+
+		if len(docs) == 0 {
+			delete(r.postings, term)
+		}
+	}
+
+	delete(r.terms, id)
+	delete(r.norms, id)
+}
+
+// refreshNormsLocked computes all norms again once the number of blogs has
+// changed by more than normRefreshRatio since they were last computed, so
+// the cost is spread over that many writes.
+func (r *relatedIndex) refreshNormsLocked() {
+	n := len(r.terms)
+
+	if math.Abs(float64(n-r.normsDocs)) <= normRefreshRatio*float64(r.normsDocs) {
+		return
+	}
+
+	for id, tf := range r.terms {
+		r.norms[id] = r.norm(tf)
+	}
+
+	r.normsDocs = n
+}
+
+func (r *relatedIndex) idf(term string) float64 {
+	n := float64(len(r.terms))
+	df := float64(len(r.postings[term]))
+
+	return math.Log((n+1)/(df+1)) + 1
+}
+
+func (r *relatedIndex) norm(tf map[string]float64) float64 {
+	sum := 0.0
+
+	for term, freq := range tf {
+		w := freq * r.idf(term)
+		sum += w * w
+	}
+
+	return math.Sqrt(sum)
+}
+
+// Related returns up to limit blogs most similar to the given one, ordered by
+// descending cosine similarity. ok is false if the blog is not indexed.
+func (r *relatedIndex) Related(id primitive.ObjectID, limit int) (results []relatedResult, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	query, ok := r.terms[id]
+
+	if !ok {
+		return nil, false
+	}
+
+	candidates := r.candidates(id, query, true)
+
+	if len(candidates) == 0 {
+		candidates = r.candidates(id, query, false)
+	}
+
+	queryNorm := r.norms[id]
+
+	if queryNorm == 0 {
+		return nil, true
+	}
+
+	top := &resultHeap{}
+
+	for candidate := range candidates {
+		tf := r.terms[candidate]
+		dot := 0.0
+
+		for term, freq := range query {
+			if other, ok := tf[term]; ok {
+				idf := r.idf(term)
+				dot += freq * idf * other * idf
+			}
+		}
+
+		if dot == 0 {
+			continue
+		}
+
+		score := dot / (queryNorm * r.norms[candidate])
+
+		if top.Len() < limit {
+			heap.Push(top, relatedResult{ID: candidate, Score: score})
+		} else if score > (*top)[0].Score {
+			(*top)[0] = relatedResult{ID: candidate, Score: score}
+			heap.Fix(top, 0)
+		}
+	}
+
+	results = make([]relatedResult, top.Len())
+
+	for i := len(results) - 1; i >= 0; i-- {
+		results[i] = heap.Pop(top).(relatedResult)
+	}
+
+	return results, true
+}
+
+// candidates returns the blogs sharing at least one term with the query. When
+// selective is set terms present in most blogs are skipped.
+func (r *relatedIndex) candidates(id primitive.ObjectID, query map[string]float64, selective bool) map[primitive.ObjectID]struct{} {
+	candidates := make(map[primitive.ObjectID]struct{})
+	maxDF := int(maxCandidateDF * float64(len(r.terms)))
+
+	for term := range query {
+		docs := r.postings[term]
+
+		if selective && len(docs) > maxDF {
+			continue
+		}
+
+		for doc := range docs {
+			if doc != id {
+				candidates[doc] = struct{}{}
+			}
+		}
+	}
+
+	return candidates
+}
+
+// resultHeap is a min-heap on score used to keep the top results.
+type resultHeap []relatedResult
+
+func (h resultHeap) Len() int { return len(h) }
+
+func (h resultHeap) Less(i, j int) bool {
+	if h[i].Score != h[j].Score {
+		return h[i].Score < h[j].Score
+	}
+
+	return h[i].ID.Hex() > h[j].ID.Hex()
+}
+
+func (h resultHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *resultHeap) Push(x interface{}) { *h = append(*h, x.(relatedResult)) }
+
+func (h *resultHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+
+	return x
+}
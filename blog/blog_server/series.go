@@ -0,0 +1,433 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// seriesServer implements SeriesService.
+type seriesServer struct {
+	blogpb.SeriesServiceServer
+
+	store seriesStore
+	blogs blogStore
+	auth  *authenticator
+}
+
+func seriesItemToPb(data *seriesItem) *blogpb.Series {
+	blogIDs := make([]string, len(data.BlogIDs))
+
+	for i, blogID := range data.BlogIDs {
+		blogIDs[i] = blogID.Hex()
+	}
+
+	return &blogpb.Series{
+		Id:          data.ID.Hex(),
+		AuthorId:    data.AuthorID,
+		Title:       data.Title,
+		Description: data.Description,
+		BlogIds:     blogIDs,
+		CreatedAt:   timestamppb.New(data.CreatedAt),
+		UpdatedAt:   timestamppb.New(data.UpdatedAt),
+	}
+}
+
+// seriesNavigation returns the position of a blog in its series and the
+// nearest readable parts around it, or nil if the blog is not part of a
+// series. Parts that are hidden by moderation, or deleted but not yet removed
+// from the series, are skipped.
+func (s *server) seriesNavigation(ctx context.Context, blogID primitive.ObjectID, prefs []language.Tag) (*blogpb.SeriesNavigation, error) {
+	series, err := s.series.SeriesOfBlog(ctx, blogID)
+
+	if err == errSeriesNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	index := partIndex(series.BlogIDs, blogID)
+
+	nav := &blogpb.SeriesNavigation{
+		SeriesId:    series.ID.Hex(),
+		SeriesTitle: series.Title,
+		Part:        int32(index + 1),
+		TotalParts:  int32(len(series.BlogIDs)),
+	}
+
+	nav.Previous, err = s.seriesPart(ctx, series.BlogIDs, index, -1, prefs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nav.Next, err = s.seriesPart(ctx, series.BlogIDs, index, 1, prefs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nav, nil
+}
+
+// seriesPart walks from index in direction step and returns the first
+// published part, or nil if there is none.
+func (s *server) seriesPart(ctx context.Context, blogIDs []primitive.ObjectID, index int, step int, prefs []language.Tag) (*blogpb.SeriesPart, error) {
+	for i := index + step; i >= 0 && i < len(blogIDs); i += step {
+		data, err := s.store.ReadBlog(ctx, blogIDs[i])
+
+		if err == errBlogNotFound {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !data.published() {
+			continue
+		}
+
+		part := &blogpb.SeriesPart{
+			BlogId: data.ID.Hex(),
+			Title:  localize(data, prefs).GetTitle(),
+			Part:   int32(i + 1),
+		}
+
+		return part, nil
+	}
+
+	return nil, nil
+}
+
+func parseSeriesID(seriesID string) (primitive.ObjectID, error) {
+	oid, err := primitive.ObjectIDFromHex(seriesID)
+
+	if err != nil {
+		return oid, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot parse series ID",
+		)
+	}
+
+	return oid, nil
+}
+
+// readOwnSeries parses a series ID and checks that the caller is the author
+// of the series.
+func (s *seriesServer) readOwnSeries(ctx context.Context, seriesID string) (*seriesItem, error) {
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	oid, err := parseSeriesID(seriesID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.store.ReadSeries(ctx, oid)
+
+	if err != nil {
+		_, err = seriesResponse(nil, err, seriesID, "")
+		return nil, err
+	}
+
+	if data.AuthorID != userID {
+		return nil, status.Errorf(
+			codes.PermissionDenied,
+			fmt.Sprintf("Series %s is not by user %v", seriesID, userID),
+		)
+	}
+
+	return data, nil
+}
+
+// seriesResponse converts a series returned by the store, or maps the store's
+// error to a status.
+func seriesResponse(data *seriesItem, err error, seriesID string, blogID string) (*blogpb.Series, error) {
+	switch err {
+	case nil:
+		return seriesItemToPb(data), nil
+	case errSeriesNotFound:
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find series with specified ID: %s", seriesID),
+		)
+	case errSeriesPartExists:
+		return nil, status.Errorf(
+			codes.AlreadyExists,
+			fmt.Sprintf("Blog %s is already part of the series", blogID),
+		)
+	case errSeriesPartTaken:
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			fmt.Sprintf("Blog %s is already part of another series", blogID),
+		)
+	case errSeriesPartNotFound:
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Blog %s is not part of the series", blogID),
+		)
+	case errSeriesPartsChanged:
+		return nil, status.Errorf(
+			codes.Aborted,
+			"Parts of the series have changed, read it again and retry",
+		)
+	}
+
+	return nil, storeError(err)
+}
+
+func (s *seriesServer) CreateSeries(ctx context.Context, req *blogpb.CreateSeriesRequest) (*blogpb.CreateSeriesResponse, error) {
+
+	fmt.Printf("Create series request: %v\n", req)
+
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	series := req.GetSeries()
+
+	if series.GetAuthorId() != "" && series.GetAuthorId() != userID {
+		return nil, status.Errorf(
+			codes.PermissionDenied,
+			fmt.Sprintf("User %v cannot create a series for %v", userID, series.GetAuthorId()),
+		)
+	}
+
+	if series.GetTitle() == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Series needs a title",
+		)
+	}
+
+	now := time.Now().UTC()
+
+	data := &seriesItem{
+		AuthorID:    userID,
+		Title:       series.GetTitle(),
+		Description: series.GetDescription(),
+		BlogIDs:     []primitive.ObjectID{},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	oid, err := s.store.CreateSeries(ctx, data)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	data.ID = oid
+
+	resp := &blogpb.CreateSeriesResponse{
+		Series: seriesItemToPb(data),
+	}
+
+	return resp, nil
+}
+
+func (s *seriesServer) GetSeries(ctx context.Context, req *blogpb.GetSeriesRequest) (*blogpb.GetSeriesResponse, error) {
+
+	fmt.Printf("Get series request: %v\n", req)
+
+	oid, err := parseSeriesID(req.GetSeriesId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.store.ReadSeries(ctx, oid)
+
+	series, err := seriesResponse(data, err, req.GetSeriesId(), "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.GetSeriesResponse{
+		Series: series,
+	}
+
+	return resp, nil
+}
+
+func (s *seriesServer) DeleteSeries(ctx context.Context, req *blogpb.DeleteSeriesRequest) (*blogpb.DeleteSeriesResponse, error) {
+
+	fmt.Printf("Delete series request: %v\n", req)
+
+	series, err := s.readOwnSeries(ctx, req.GetSeriesId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.DeleteSeries(ctx, series.ID)
+
+	if err != nil {
+		_, err = seriesResponse(nil, err, req.GetSeriesId(), "")
+		return nil, err
+	}
+
+	resp := &blogpb.DeleteSeriesResponse{
+		SeriesId: req.GetSeriesId(),
+	}
+
+	return resp, nil
+}
+
+func (s *seriesServer) AddSeriesPart(ctx context.Context, req *blogpb.AddSeriesPartRequest) (*blogpb.AddSeriesPartResponse, error) {
+
+	fmt.Printf("Add series part request: %v\n", req)
+
+	series, err := s.readOwnSeries(ctx, req.GetSeriesId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	blogID, err := parseBlogID(req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPart() < 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Part cannot be negative",
+		)
+	}
+
+	blog, err := s.blogs.ReadBlog(ctx, blogID)
+
+	if err == errBlogNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", req.GetBlogId()),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	if blog.AuthorID != series.AuthorID {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			fmt.Sprintf("Blog %s is not by the author of the series", req.GetBlogId()),
+		)
+	}
+
+	index := len(series.BlogIDs)
+
+	if part := int(req.GetPart()); part > 0 && part <= index {
+		index = part - 1
+	}
+
+	data, err := s.store.InsertPart(ctx, series.ID, blogID, index)
+
+	updated, err := seriesResponse(data, err, req.GetSeriesId(), req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.AddSeriesPartResponse{
+		Series: updated,
+	}
+
+	return resp, nil
+}
+
+func (s *seriesServer) RemoveSeriesPart(ctx context.Context, req *blogpb.RemoveSeriesPartRequest) (*blogpb.RemoveSeriesPartResponse, error) {
+
+	fmt.Printf("Remove series part request: %v\n", req)
+
+	series, err := s.readOwnSeries(ctx, req.GetSeriesId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	blogID, err := parseBlogID(req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.store.RemovePart(ctx, series.ID, blogID)
+
+	updated, err := seriesResponse(data, err, req.GetSeriesId(), req.GetBlogId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.RemoveSeriesPartResponse{
+		Series: updated,
+	}
+
+	return resp, nil
+}
+
+func (s *seriesServer) ReorderSeries(ctx context.Context, req *blogpb.ReorderSeriesRequest) (*blogpb.ReorderSeriesResponse, error) {
+
+	fmt.Printf("Reorder series request: %v\n", req)
+
+	series, err := s.readOwnSeries(ctx, req.GetSeriesId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	blogIDs := make([]primitive.ObjectID, len(req.GetBlogIds()))
+	seen := make(map[primitive.ObjectID]bool, len(blogIDs))
+
+	for i, id := range req.GetBlogIds() {
+		blogID, err := parseBlogID(id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[blogID] {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Blog %s is listed more than once", id),
+			)
+		}
+
+		seen[blogID] = true
+		blogIDs[i] = blogID
+	}
+
+	data, err := s.store.ReorderParts(ctx, series.ID, blogIDs)
+
+	updated, err := seriesResponse(data, err, req.GetSeriesId(), "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.ReorderSeriesResponse{
+		Series: updated,
+	}
+
+	return resp, nil
+}
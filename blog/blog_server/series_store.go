@@ -0,0 +1,524 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	errSeriesNotFound     = errors.New("series not found")
+	errSeriesPartExists   = errors.New("blog is already part of the series")
+	errSeriesPartNotFound = errors.New("blog is not part of the series")
+	errSeriesPartsChanged = errors.New("parts of the series have changed")
+	errSeriesPartTaken    = errors.New("blog is part of another series")
+)
+
+// seriesClaimTimeout is how long a blog stays claimed by a series that does
+// not hold it, after which the claim is taken to be left by an interrupted
+// change. It must be longer than adding a part can take.
+const seriesClaimTimeout = time.Minute
+
+// seriesItem is an ordered collection of blogs. The order of BlogIDs is the
+// reading order, so removing a part closes the gap it leaves.
+type seriesItem struct {
+	ID          primitive.ObjectID   `bson:"_id,omitempty"`
+	AuthorID    string               `bson:"author_id"`
+	Title       string               `bson:"title"`
+	Description string               `bson:"description,omitempty"`
+	BlogIDs     []primitive.ObjectID `bson:"blog_ids"`
+	CreatedAt   time.Time            `bson:"created_at"`
+	UpdatedAt   time.Time            `bson:"updated_at"`
+}
+
+// seriesPartItem claims a blog for a series. The unique index on blog_id is
+// what keeps a blog in at most one series, the blog_ids of the series are
+// only changed once the claim is made.
+type seriesPartItem struct {
+	BlogID    primitive.ObjectID `bson:"blog_id"`
+	SeriesID  primitive.ObjectID `bson:"series_id"`
+	ClaimedAt time.Time          `bson:"claimed_at"`
+}
+
+// seriesStore persists series. A blog is part of at most one series. The
+// methods that change the parts of a series return it as it is after the
+// change.
+type seriesStore interface {
+	CreateSeries(ctx context.Context, data *seriesItem) (primitive.ObjectID, error)
+	ReadSeries(ctx context.Context, id primitive.ObjectID) (*seriesItem, error)
+	// SeriesOfBlog returns errSeriesNotFound if the blog is not part of a
+	// series.
+	SeriesOfBlog(ctx context.Context, blogID primitive.ObjectID) (*seriesItem, error)
+	DeleteSeries(ctx context.Context, id primitive.ObjectID) error
+
+	// InsertPart inserts a blog at index, appending it when index is past
+	// the end. It returns errSeriesPartTaken if the blog is part of another
+	// series.
+	InsertPart(ctx context.Context, id primitive.ObjectID, blogID primitive.ObjectID, index int) (*seriesItem, error)
+	RemovePart(ctx context.Context, id primitive.ObjectID, blogID primitive.ObjectID) (*seriesItem, error)
+	// ReorderParts returns errSeriesPartsChanged unless blogIDs holds
+	// exactly the current parts, each once.
+	ReorderParts(ctx context.Context, id primitive.ObjectID, blogIDs []primitive.ObjectID) (*seriesItem, error)
+	// RemoveBlog takes a deleted blog out of any series it is part of.
+	RemoveBlog(ctx context.Context, blogID primitive.ObjectID) error
+}
+
+// mongoSeriesStore is a seriesStore backed by MongoDB collections, one for
+// the series and one for the claims of their parts.
+type mongoSeriesStore struct {
+	collection *mongo.Collection
+	parts      *mongo.Collection
+}
+
+func newMongoSeriesStore(db *mongo.Database) *mongoSeriesStore {
+	return &mongoSeriesStore{
+		collection: db.Collection("series"),
+		parts:      db.Collection("series_parts"),
+	}
+}
+
+// EnsureIndexes creates the indexes the store relies on.
+func (m *mongoSeriesStore) EnsureIndexes(ctx context.Context) error {
+	_, err := m.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "blog_ids", Value: 1}},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = m.parts.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "blog_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "series_id", Value: 1}},
+		},
+	})
+
+	return err
+}
+
+func (m *mongoSeriesStore) CreateSeries(ctx context.Context, data *seriesItem) (primitive.ObjectID, error) {
+	item := *data
+
+	// $push and $pull fail on a missing or null array.
+	if item.BlogIDs == nil {
+		item.BlogIDs = []primitive.ObjectID{}
+	}
+
+	res, err := m.collection.InsertOne(ctx, &item)
+
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+
+	if !ok {
+		return primitive.NilObjectID, errors.New("cannot convert to OID")
+	}
+
+	return oid, nil
+}
+
+func (m *mongoSeriesStore) findOne(ctx context.Context, filter bson.D) (*seriesItem, error) {
+	data := &seriesItem{}
+
+	err := m.collection.FindOne(ctx, filter).Decode(data)
+
+	if err == mongo.ErrNoDocuments {
+		return nil, errSeriesNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (m *mongoSeriesStore) ReadSeries(ctx context.Context, id primitive.ObjectID) (*seriesItem, error) {
+	return m.findOne(ctx, bson.D{{Key: "_id", Value: id}})
+}
+
+func (m *mongoSeriesStore) SeriesOfBlog(ctx context.Context, blogID primitive.ObjectID) (*seriesItem, error) {
+	return m.findOne(ctx, bson.D{{Key: "blog_ids", Value: blogID}})
+}
+
+func (m *mongoSeriesStore) DeleteSeries(ctx context.Context, id primitive.ObjectID) error {
+	res, err := m.collection.DeleteOne(ctx, bson.D{{Key: "_id", Value: id}})
+
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return errSeriesNotFound
+	}
+
+	_, err = m.parts.DeleteMany(ctx, bson.D{{Key: "series_id", Value: id}})
+
+	return err
+}
+
+// claimPart claims blogID for the series id and reports whether the claim is
+// new. A claim by another series is taken over if that series does not hold
+// the blog and the claim has timed out.
+func (m *mongoSeriesStore) claimPart(ctx context.Context, id primitive.ObjectID, blogID primitive.ObjectID) (bool, error) {
+	now := time.Now().UTC()
+
+	_, err := m.parts.InsertOne(ctx, &seriesPartItem{BlogID: blogID, SeriesID: id, ClaimedAt: now})
+
+	if err == nil {
+		return true, nil
+	}
+
+	if !mongo.IsDuplicateKeyError(err) {
+		return false, err
+	}
+
+	current := &seriesPartItem{}
+
+	err = m.parts.FindOne(ctx, bson.D{{Key: "blog_id", Value: blogID}}).Decode(current)
+
+	if err == mongo.ErrNoDocuments {
+		return false, errSeriesPartsChanged
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if current.SeriesID == id {
+		return false, nil
+	}
+
+	if now.Sub(current.ClaimedAt) < seriesClaimTimeout {
+		return false, errSeriesPartTaken
+	}
+
+	held, err := m.collection.CountDocuments(ctx, bson.D{{Key: "_id", Value: current.SeriesID}, {Key: "blog_ids", Value: blogID}})
+
+	if err != nil {
+		return false, err
+	}
+
+	if held > 0 {
+		return false, errSeriesPartTaken
+	}
+
+	filter := bson.D{{Key: "blog_id", Value: blogID}, {Key: "series_id", Value: current.SeriesID}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "series_id", Value: id}, {Key: "claimed_at", Value: now}}}}
+
+	res, err := m.parts.UpdateOne(ctx, filter, update)
+
+	if err != nil {
+		return false, err
+	}
+
+	if res.ModifiedCount == 0 {
+		return false, errSeriesPartsChanged
+	}
+
+	return true, nil
+}
+
+// releasePart removes the claim of the series id on blogID. A claim left
+// behind when this fails is taken over once it times out.
+func (m *mongoSeriesStore) releasePart(ctx context.Context, id primitive.ObjectID, blogID primitive.ObjectID) error {
+	_, err := m.parts.DeleteOne(ctx, bson.D{{Key: "blog_id", Value: blogID}, {Key: "series_id", Value: id}})
+
+	return err
+}
+
+// updatePart applies update and sets the fields in set, along with
+// updated_at, if the series matches the part condition. When nothing matches,
+// the series is read again to tell whether it is missing or the condition
+// failed, in which case conflict is returned.
+func (m *mongoSeriesStore) updatePart(ctx context.Context, id primitive.ObjectID, condition bson.E, update bson.D, set bson.D, conflict error) (*seriesItem, error) {
+	filter := bson.D{{Key: "_id", Value: id}, condition}
+
+	set = append(set, bson.E{Key: "updated_at", Value: time.Now().UTC()})
+	update = append(update, bson.E{Key: "$set", Value: set})
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	data := &seriesItem{}
+
+	err := m.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(data)
+
+	if err == mongo.ErrNoDocuments {
+		if _, err := m.ReadSeries(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return nil, conflict
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (m *mongoSeriesStore) InsertPart(ctx context.Context, id primitive.ObjectID, blogID primitive.ObjectID, index int) (*seriesItem, error) {
+	condition := bson.E{Key: "blog_ids", Value: bson.D{{Key: "$ne", Value: blogID}}}
+
+	update := bson.D{{Key: "$push", Value: bson.D{{Key: "blog_ids", Value: bson.D{
+		{Key: "$each", Value: []primitive.ObjectID{blogID}},
+		{Key: "$position", Value: index},
+	}}}}}
+
+	claimed, err := m.claimPart(ctx, id, blogID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := m.updatePart(ctx, id, condition, update, nil, errSeriesPartExists)
+
+	if err != nil && claimed {
+		m.releasePart(ctx, id, blogID)
+	}
+
+	return data, err
+}
+
+func (m *mongoSeriesStore) RemovePart(ctx context.Context, id primitive.ObjectID, blogID primitive.ObjectID) (*seriesItem, error) {
+	condition := bson.E{Key: "blog_ids", Value: blogID}
+
+	update := bson.D{{Key: "$pull", Value: bson.D{{Key: "blog_ids", Value: blogID}}}}
+
+	data, err := m.updatePart(ctx, id, condition, update, nil, errSeriesPartNotFound)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.releasePart(ctx, id, blogID); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (m *mongoSeriesStore) ReorderParts(ctx context.Context, id primitive.ObjectID, blogIDs []primitive.ObjectID) (*seriesItem, error) {
+	if blogIDs == nil {
+		blogIDs = []primitive.ObjectID{}
+	}
+
+	// The callers reject duplicates, so an array of the same size holding
+	// all of blogIDs has the same parts. $all never matches an empty list.
+	parts := bson.D{{Key: "$size", Value: len(blogIDs)}}
+
+	if len(blogIDs) > 0 {
+		parts = append(parts, bson.E{Key: "$all", Value: blogIDs})
+	}
+
+	condition := bson.E{Key: "blog_ids", Value: parts}
+
+	set := bson.D{{Key: "blog_ids", Value: blogIDs}}
+
+	return m.updatePart(ctx, id, condition, bson.D{}, set, errSeriesPartsChanged)
+}
+
+func (m *mongoSeriesStore) RemoveBlog(ctx context.Context, blogID primitive.ObjectID) error {
+	update := bson.D{
+		{Key: "$pull", Value: bson.D{{Key: "blog_ids", Value: blogID}}},
+		{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now().UTC()}}},
+	}
+
+	_, err := m.collection.UpdateMany(ctx, bson.D{{Key: "blog_ids", Value: blogID}}, update)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = m.parts.DeleteOne(ctx, bson.D{{Key: "blog_id", Value: blogID}})
+
+	return err
+}
+
+// memorySeriesStore is a seriesStore kept in process memory.
+type memorySeriesStore struct {
+	mu     sync.Mutex
+	series map[primitive.ObjectID]*seriesItem
+}
+
+func newMemorySeriesStore() *memorySeriesStore {
+	return &memorySeriesStore{
+		series: make(map[primitive.ObjectID]*seriesItem),
+	}
+}
+
+func copySeriesItem(data *seriesItem) *seriesItem {
+	item := *data
+	item.BlogIDs = append([]primitive.ObjectID{}, data.BlogIDs...)
+
+	return &item
+}
+
+func partIndex(blogIDs []primitive.ObjectID, blogID primitive.ObjectID) int {
+	for i, id := range blogIDs {
+		if id == blogID {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (m *memorySeriesStore) CreateSeries(ctx context.Context, data *seriesItem) (primitive.ObjectID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item := copySeriesItem(data)
+	item.ID = primitive.NewObjectID()
+
+	m.series[item.ID] = item
+
+	return item.ID, nil
+}
+
+func (m *memorySeriesStore) ReadSeries(ctx context.Context, id primitive.ObjectID) (*seriesItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.series[id]
+
+	if !ok {
+		return nil, errSeriesNotFound
+	}
+
+	return copySeriesItem(data), nil
+}
+
+func (m *memorySeriesStore) SeriesOfBlog(ctx context.Context, blogID primitive.ObjectID) (*seriesItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, data := range m.series {
+		if partIndex(data.BlogIDs, blogID) >= 0 {
+			return copySeriesItem(data), nil
+		}
+	}
+
+	return nil, errSeriesNotFound
+}
+
+func (m *memorySeriesStore) DeleteSeries(ctx context.Context, id primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.series[id]; !ok {
+		return errSeriesNotFound
+	}
+
+	delete(m.series, id)
+
+	return nil
+}
+
+func (m *memorySeriesStore) InsertPart(ctx context.Context, id primitive.ObjectID, blogID primitive.ObjectID, index int) (*seriesItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.series[id]
+
+	if !ok {
+		return nil, errSeriesNotFound
+	}
+
+	if partIndex(data.BlogIDs, blogID) >= 0 {
+		return nil, errSeriesPartExists
+	}
+
+	for _, other := range m.series {
+		if partIndex(other.BlogIDs, blogID) >= 0 {
+			return nil, errSeriesPartTaken
+		}
+	}
+
+	if index > len(data.BlogIDs) {
+		index = len(data.BlogIDs)
+	}
+
+	blogIDs := append([]primitive.ObjectID{}, data.BlogIDs[:index]...)
+	blogIDs = append(blogIDs, blogID)
+	blogIDs = append(blogIDs, data.BlogIDs[index:]...)
+
+	data.BlogIDs = blogIDs
+	data.UpdatedAt = time.Now().UTC()
+
+	return copySeriesItem(data), nil
+}
+
+func (m *memorySeriesStore) RemovePart(ctx context.Context, id primitive.ObjectID, blogID primitive.ObjectID) (*seriesItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.series[id]
+
+	if !ok {
+		return nil, errSeriesNotFound
+	}
+
+	index := partIndex(data.BlogIDs, blogID)
+
+	if index < 0 {
+		return nil, errSeriesPartNotFound
+	}
+
+	data.BlogIDs = append(data.BlogIDs[:index:index], data.BlogIDs[index+1:]...)
+	data.UpdatedAt = time.Now().UTC()
+
+	return copySeriesItem(data), nil
+}
+
+func (m *memorySeriesStore) ReorderParts(ctx context.Context, id primitive.ObjectID, blogIDs []primitive.ObjectID) (*seriesItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.series[id]
+
+	if !ok {
+		return nil, errSeriesNotFound
+	}
+
+	if len(blogIDs) != len(data.BlogIDs) {
+		return nil, errSeriesPartsChanged
+	}
+
+	for _, blogID := range blogIDs {
+		if partIndex(data.BlogIDs, blogID) < 0 {
+			return nil, errSeriesPartsChanged
+		}
+	}
+
+	data.BlogIDs = append([]primitive.ObjectID{}, blogIDs...)
+	data.UpdatedAt = time.Now().UTC()
+
+	return copySeriesItem(data), nil
+}
+
+func (m *memorySeriesStore) RemoveBlog(ctx context.Context, blogID primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, data := range m.series {
+		if index := partIndex(data.BlogIDs, blogID); index >= 0 {
+			data.BlogIDs = append(data.BlogIDs[:index:index], data.BlogIDs[index+1:]...)
+			data.UpdatedAt = time.Now().UTC()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMemorySeriesStoreParts(t *testing.T) {
+	ctx := context.Background()
+	a, b, c := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+
+	tests := []struct {
+		name    string
+		change  func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error)
+		want    []primitive.ObjectID
+		wantErr error
+	}{
+		{
+			name: "insert at the front",
+			change: func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error) {
+				return store.InsertPart(ctx, id, c, 0)
+			},
+			want: []primitive.ObjectID{c, a, b},
+		},
+		{
+			name: "insert past the end appends",
+			change: func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error) {
+				return store.InsertPart(ctx, id, c, 10)
+			},
+			want: []primitive.ObjectID{a, b, c},
+		},
+		{
+			name: "insert a part twice",
+			change: func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error) {
+				return store.InsertPart(ctx, id, b, 0)
+			},
+			want:    []primitive.ObjectID{a, b},
+			wantErr: errSeriesPartExists,
+		},
+		{
+			name: "insert a part of another series",
+			change: func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error) {
+				return store.InsertPart(ctx, other, a, 0)
+			},
+			want:    []primitive.ObjectID{a, b},
+			wantErr: errSeriesPartTaken,
+		},
+		{
+			name: "remove closes the gap",
+			change: func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error) {
+				return store.RemovePart(ctx, id, a)
+			},
+			want: []primitive.ObjectID{b},
+		},
+		{
+			name: "remove a missing part",
+			change: func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error) {
+				return store.RemovePart(ctx, id, c)
+			},
+			want:    []primitive.ObjectID{a, b},
+			wantErr: errSeriesPartNotFound,
+		},
+		{
+			name: "reorder",
+			change: func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error) {
+				return store.ReorderParts(ctx, id, []primitive.ObjectID{b, a})
+			},
+			want: []primitive.ObjectID{b, a},
+		},
+		{
+			name: "reorder with a stale list",
+			change: func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error) {
+				return store.ReorderParts(ctx, id, []primitive.ObjectID{b, c})
+			},
+			want:    []primitive.ObjectID{a, b},
+			wantErr: errSeriesPartsChanged,
+		},
+		{
+			name: "deleted blog leaves its series",
+			change: func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error) {
+				if err := store.RemoveBlog(ctx, a); err != nil {
+					return nil, err
+				}
+
+				return store.ReadSeries(ctx, id)
+			},
+			want: []primitive.ObjectID{b},
+		},
+		{
+			name: "missing series",
+			change: func(store *memorySeriesStore, id primitive.ObjectID, other primitive.ObjectID) (*seriesItem, error) {
+				return store.InsertPart(ctx, primitive.NewObjectID(), c, 0)
+			},
+			want:    []primitive.ObjectID{a, b},
+			wantErr: errSeriesNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemorySeriesStore()
+
+			id, _ := store.CreateSeries(ctx, &seriesItem{AuthorID: "author", Title: "series"})
+			other, _ := store.CreateSeries(ctx, &seriesItem{AuthorID: "author", Title: "other"})
+
+			for _, blogID := range []primitive.ObjectID{a, b} {
+				if _, err := store.InsertPart(ctx, id, blogID, 10); err != nil {
+					t.Fatalf("InsertPart: %v", err)
+				}
+			}
+
+			_, err := tt.change(store, id, other)
+
+			if err != tt.wantErr {
+				t.Fatalf("got %v, want %v", err, tt.wantErr)
+			}
+
+			data, err := store.ReadSeries(ctx, id)
+
+			if err != nil {
+				t.Fatalf("ReadSeries: %v", err)
+			}
+
+			if fmt.Sprint(data.BlogIDs) != fmt.Sprint(tt.want) {
+				t.Errorf("got parts %v, want %v", data.BlogIDs, tt.want)
+			}
+		})
+	}
+}
+
+// TestMongoSeriesStoreClaimPart needs a MongoDB server, given by
+// $BLOG_TEST_MONGO_URI. It works in a database of its own, dropped at the
+// end.
+func TestMongoSeriesStoreClaimPart(t *testing.T) {
+	uri := os.Getenv("BLOG_TEST_MONGO_URI")
+
+	if uri == "" {
+		t.Skip("BLOG_TEST_MONGO_URI is not set")
+	}
+
+	client, err := connectMongo(mongoConfig{
+		URI:              uri,
+		ConnectTimeout:   5 * time.Second,
+		OperationTimeout: 10 * time.Second,
+		StartupTimeout:   10 * time.Second,
+	})
+
+	if err != nil {
+		t.Fatalf("connectMongo: %v", err)
+	}
+
+	ctx := context.Background()
+	db := client.Database("blog_test_" + primitive.NewObjectID().Hex())
+
+	defer client.Disconnect(ctx)
+	defer db.Drop(ctx)
+
+	store := newMongoSeriesStore(db)
+
+	if err := store.EnsureIndexes(ctx); err != nil {
+		t.Fatalf("EnsureIndexes: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		// setup makes the claim of owner on the blog, if any, and returns
+		// the series that then claims it.
+		setup       func(blogID primitive.ObjectID, owner primitive.ObjectID) primitive.ObjectID
+		wantClaimed bool
+		wantErr     error
+	}{
+		{
+			name: "unclaimed blog",
+			setup: func(blogID primitive.ObjectID, owner primitive.ObjectID) primitive.ObjectID {
+				return owner
+			},
+			wantClaimed: true,
+		},
+		{
+			name: "claimed by the same series",
+			setup: func(blogID primitive.ObjectID, owner primitive.ObjectID) primitive.ObjectID {
+				store.claimPart(ctx, owner, blogID)
+				return owner
+			},
+		},
+		{
+			name: "recently claimed by another series",
+			setup: func(blogID primitive.ObjectID, owner primitive.ObjectID) primitive.ObjectID {
+				store.claimPart(ctx, owner, blogID)
+				return primitive.NewObjectID()
+			},
+			wantErr: errSeriesPartTaken,
+		},
+		{
+			name: "left behind by another series",
+			setup: func(blogID primitive.ObjectID, owner primitive.ObjectID) primitive.ObjectID {
+				store.claimPart(ctx, owner, blogID)
+				expireClaim(t, store, blogID)
+				return primitive.NewObjectID()
+			},
+			wantClaimed: true,
+		},
+		{
+			name: "held by another series",
+			setup: func(blogID primitive.ObjectID, owner primitive.ObjectID) primitive.ObjectID {
+				if _, err := store.InsertPart(ctx, owner, blogID, 0); err != nil {
+					t.Fatalf("InsertPart: %v", err)
+				}
+
+				expireClaim(t, store, blogID)
+				return primitive.NewObjectID()
+			},
+			wantErr: errSeriesPartTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, err := store.CreateSeries(ctx, &seriesItem{AuthorID: "author", Title: "series"})
+
+			if err != nil {
+				t.Fatalf("CreateSeries: %v", err)
+			}
+
+			blogID := primitive.NewObjectID()
+			id := tt.setup(blogID, owner)
+
+			claimed, err := store.claimPart(ctx, id, blogID)
+
+			if claimed != tt.wantClaimed || err != tt.wantErr {
+				t.Errorf("got %v, %v, want %v, %v", claimed, err, tt.wantClaimed, tt.wantErr)
+			}
+		})
+	}
+}
+
+// expireClaim backdates the claim on blogID past seriesClaimTimeout.
+func expireClaim(t *testing.T, store *mongoSeriesStore, blogID primitive.ObjectID) {
+	t.Helper()
+
+	_, err := store.parts.UpdateOne(
+		context.Background(),
+		bson.D{{Key: "blog_id", Value: blogID}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "claimed_at", Value: time.Now().UTC().Add(-2 * seriesClaimTimeout)}}}},
+	)
+
+	if err != nil {
+		t.Fatalf("UpdateOne: %v", err)
+	}
+}
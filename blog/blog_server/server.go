@@ -2,79 +2,201 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"sort"
 	"time"
 
 	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var blogCollection *mongo.Collection
-
 type server struct {
 	blogpb.BlogServiceServer
+
+	store      blogStore
+	related    *relatedIndex
+	webhooks   *webhookDispatcher
+	reactions  *reactionHub
+	views      *viewCounter
+	moderation moderationFilter
+	series     seriesStore
+	bookmarks  readingListStore
+	auth       *authenticator
+}
+
+// prepareEvent queues a webhook event before the blog write it reports, which
+// must not be made if this fails. The event is not tied to the request so
+// that a client going away cannot leave it half written.
+func (s *server) prepareEvent(eventType string, data *blogItem) (string, error) {
+	return s.webhooks.Prepare(context.Background(), eventType, data)
+}
+
+// confirmEvent releases the event of a blog write that was made. On failure
+// the event is still delivered once the dispatcher has checked the write.
+func (s *server) confirmEvent(eventID string) {
+	if err := s.webhooks.Confirm(context.Background(), eventID); err != nil {
+		fmt.Printf("Failed to confirm webhook event %v: %v\n", eventID, err)
+	}
+}
+
+// abandonEvent drops the event of a blog write that failed. On failure the
+// dispatcher drops the event once it finds the write was not made.
+func (s *server) abandonEvent(eventID string) {
+	if err := s.webhooks.Abandon(context.Background(), eventID); err != nil {
+		fmt.Printf("Failed to drop webhook event %v: %v\n", eventID, err)
+	}
 }
 
 type blogItem struct {
-	ID       primitive.ObjectID `bson:"_id,omitempty"`
-	AuthorID string             `bson:"author_id"`
-	Content  string             `bson:"content"`
-	Title    string             `bson:"title"`
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	AuthorID      string             `bson:"author_id"`
+	Content       string             `bson:"content"`
+	Title         string             `bson:"title"`
+	Tags          []string           `bson:"tags,omitempty"`
+	SchemaVersion int                `bson:"schema_version,omitempty"`
+	// ReactionCounts is keyed by reaction name and only changed through the
+	// reaction methods of the store.
+	ReactionCounts map[string]int64 `bson:"reaction_counts,omitempty"`
+	UpdatedAt      time.Time        `bson:"updated_at,omitempty"`
+	// Revision counts the updates of the blog, so that a change decided on
+	// an older read can be refused.
+	Revision int64 `bson:"revision,omitempty"`
+	// Status is one of the blogStatus constants. Blogs saved before
+	// moderation existed have none and count as published.
+	Status            string   `bson:"status,omitempty"`
+	ModerationReasons []string `bson:"moderation_reasons,omitempty"`
+	// Language is the BCP 47 tag of Title and Content, empty if unknown.
+	Language string `bson:"language,omitempty"`
+	// Translations is keyed by canonical BCP 47 tag.
+	Translations map[string]blogTranslation `bson:"translations,omitempty"`
 }
 
-func (*server) CreateBlog(ctx context.Context, req *blogpb.CreateBlogRequest) (*blogpb.CreateBlogResponse, error) {
+// published reports whether the blog may be shown to readers.
+func (data *blogItem) published() bool {
+	return data.Status == "" || data.Status == blogStatusPublished
+}
+
+// updatedAt returns when the blog was last written. Blogs saved before the
+// field existed fall back to their creation time.
+func (data *blogItem) updatedAt() time.Time {
+	if data.UpdatedAt.IsZero() {
+		return data.ID.Timestamp()
+	}
+
+	return data.UpdatedAt
+}
+
+func blogItemToPb(data *blogItem) *blogpb.Blog {
+	return &blogpb.Blog{
+		Id:                 data.ID.Hex(),
+		AuthorId:           data.AuthorID,
+		Title:              data.Title,
+		Content:            data.Content,
+		Tags:               data.Tags,
+		Reactions:          reactionCountsToPb(data.ReactionCounts),
+		CreatedAt:          timestamppb.New(data.ID.Timestamp()),
+		UpdatedAt:          timestamppb.New(data.updatedAt()),
+		Status:             blogStatusToPb(data.Status),
+		ModerationReasons:  data.ModerationReasons,
+		Language:           data.Language,
+		AvailableLanguages: availableLanguages(data),
+	}
+}
+
+func (s *server) CreateBlog(ctx context.Context, req *blogpb.CreateBlogRequest) (*blogpb.CreateBlogResponse, error) {
 
 	fmt.Printf("Create blog request: %v\n", req)
 
 	blog := req.GetBlog()
 
-	data := blogItem{
-		AuthorID: blog.GetAuthorId(),
-		Title:    blog.GetContent(),
-		Content:  blog.GetTitle(),
+	authorID, err := s.blogAuthor(ctx, blog.GetAuthorId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	lang := blog.GetLanguage()
+
+	if lang != "" {
+		if lang, err = parseLanguage(lang); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Invalid language: %v", err),
+			)
+		}
+	}
+
+	data := &blogItem{
+		AuthorID:      authorID,
+		Title:         blog.GetTitle(),
+		Content:       blog.GetContent(),
+		Tags:          blog.GetTags(),
+		SchemaVersion: currentBlogSchemaVersion,
+		UpdatedAt:     time.Now().UTC(),
+		Language:      lang,
 	}
 
-	res, err := blogCollection.InsertOne(context.Background(), data)
+	s.moderate(data)
+
+	// The ID is chosen here so that the webhook event can be queued first.
+	data.ID = primitive.NewObjectID()
+
+	eventID, err := s.prepareWrite(data, false)
 
 	if err != nil {
-		log.Fatalf("Failed to insert to DB: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal error: %v", err))
+		return nil, storeError(err)
 	}
 
-	oid, ok := res.InsertedID.(primitive.ObjectID)
+	oid, err := s.store.CreateBlog(ctx, data)
 
-	if !ok {
-		return nil, status.Errorf(
-			codes.Internal,
-			"Cannot convert to OID",
-		)
+	if err != nil {
+		fmt.Printf("Failed to insert to DB: %v\n", err)
+		s.abandonEvent(eventID)
+		return nil, storeError(err)
 	}
 
+	data.ID = oid
+	s.written(data, false, eventID)
+
 	resp := &blogpb.CreateBlogResponse{
-		Blog: &blogpb.Blog{
-			Id:       oid.Hex(),
-			AuthorId: blog.GetAuthorId(),
-			Title:    blog.GetTitle(),
-			Content:  blog.GetContent(),
-		},
+		Blog: blogItemToPb(data),
 	}
 
 	return resp, nil
 }
 
-func (*server) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blogpb.ReadBlogResponse, error) {
+// blogAuthor returns the authenticated caller, who can only write blogs as
+// themselves.
+func (s *server) blogAuthor(ctx context.Context, requested string) (string, error) {
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	if requested != "" && requested != userID {
+		return "", status.Errorf(
+			codes.PermissionDenied,
+			fmt.Sprintf("User %v cannot write blogs as %v", userID, requested),
+		)
+	}
+
+	return userID, nil
+}
+
+func (s *server) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blogpb.ReadBlogResponse, error) {
 
 	fmt.Printf("Read blog request: %v\n", req)
 
@@ -89,17 +211,15 @@ func (*server) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blog
 		)
 	}
 
-	data := &blogItem{}
-	filter := bson.D{
-		{
-			Key:   "_id",
-			Value: oid,
-		},
+	prefs, err := localePreferences(ctx, req.GetLocale())
+
+	if err != nil {
+		return nil, err
 	}
 
-	findErr := blogCollection.FindOne(context.Background(), filter).Decode(data)
+	data, findErr := s.store.ReadBlog(ctx, oid)
 
-	if findErr == mongo.ErrNoDocuments {
+	if findErr == errBlogNotFound {
 		return nil, status.Errorf(
 			codes.NotFound,
 			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
@@ -107,31 +227,45 @@ func (*server) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blog
 	}
 
 	if findErr != nil {
+		return nil, storeError(findErr)
+	}
+
+	if !data.published() {
 		return nil, status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Internal error: %v", findErr),
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
 		)
 	}
 
+	nav, err := s.seriesNavigation(ctx, oid, prefs)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	s.views.Record(oid, s.viewer(ctx))
+
 	resp := &blogpb.ReadBlogResponse{
-		Blog: &blogpb.Blog{
-			Id:       data.ID.Hex(),
-			AuthorId: data.AuthorID,
-			Title:    data.Title,
-			Content:  data.Content,
-		},
+		Blog:   localize(data, prefs),
+		Series: nav,
 	}
 
 	return resp, nil
 
 }
 
-func (*server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*blogpb.UpdateBlogResponse, error) {
+func (s *server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*blogpb.UpdateBlogResponse, error) {
 	fmt.Printf("Update blog request: %v\n", req)
 
 	blog := req.GetBlog()
 	blogID := blog.GetId()
 
+	authorID, err := s.blogAuthor(ctx, blog.GetAuthorId())
+
+	if err != nil {
+		return nil, err
+	}
+
 	oid, err := primitive.ObjectIDFromHex(blogID)
 
 	if err != nil {
@@ -141,17 +275,20 @@ func (*server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*
 		)
 	}
 
-	data := &blogItem{}
-	filter := bson.D{
-		{
-			Key:   "_id",
-			Value: oid,
-		},
+	lang := blog.GetLanguage()
+
+	if lang != "" {
+		if lang, err = parseLanguage(lang); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Invalid language: %v", err),
+			)
+		}
 	}
 
-	findErr := blogCollection.FindOne(context.Background(), filter).Decode(data)
+	data, findErr := s.store.ReadBlog(ctx, oid)
 
-	if findErr == mongo.ErrNoDocuments {
+	if findErr == errBlogNotFound {
 		return nil, status.Errorf(
 			codes.NotFound,
 			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
@@ -159,51 +296,86 @@ func (*server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*
 	}
 
 	if findErr != nil {
+		return nil, storeError(findErr)
+	}
+
+	if data.AuthorID != authorID {
+		return nil, status.Errorf(
+			codes.PermissionDenied,
+			fmt.Sprintf("Blog %s is not by user %v", blogID, authorID),
+		)
+	}
+
+	if _, ok := data.Translations[lang]; ok {
 		return nil, status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Internal error: %v", findErr),
+			codes.FailedPrecondition,
+			fmt.Sprintf("Blog has a %v translation, remove it before making it the original language", lang),
 		)
 	}
 
-	data.AuthorID = blog.GetAuthorId()
+	wasPublished := data.published()
+	previousTitle := data.Title
+
 	data.Content = blog.GetContent()
 	data.Title = blog.GetTitle()
+	data.Tags = blog.GetTags()
+	data.Language = lang
+	data.SchemaVersion = currentBlogSchemaVersion
+	data.UpdatedAt = time.Now().UTC()
+
+	s.moderate(data)
+
+	eventID, err := s.prepareWrite(data, wasPublished)
 
-	updateRes, updateErr := blogCollection.ReplaceOne(context.Background(), filter, data)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	updateErr := s.store.UpdateBlog(ctx, data)
 
 	if updateErr != nil {
-		return nil, status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Internal error: %v", updateErr),
-		)
+		s.abandonEvent(eventID)
 	}
 
-	if updateRes.ModifiedCount == 0 {
+	if updateErr == errBlogNotFound {
 		return nil, status.Errorf(
 			codes.NotFound,
 			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
 		)
 	}
 
+	if updateErr != nil {
+		return nil, storeError(updateErr)
+	}
+
+	s.written(data, wasPublished, eventID)
+
+	// Bookmarks remember the title for when the blog is deleted. A blog
+	// published again may have a title they never saw.
+	if data.Title != previousTitle || !wasPublished {
+		s.rememberTitle(ctx, data)
+	}
+
 	resp := &blogpb.UpdateBlogResponse{
-		Blog: &blogpb.Blog{
-			Id:       data.ID.Hex(),
-			AuthorId: data.AuthorID,
-			Title:    data.Title,
-			Content:  data.Content,
-		},
+		Blog: blogItemToPb(data),
 	}
 
 	return resp, nil
 
 }
 
-func (*server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*blogpb.DeleteBlogResponse, error) {
+func (s *server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*blogpb.DeleteBlogResponse, error) {
 
 	fmt.Printf("Delete blog request: %v\n", req)
 
 	blogID := req.GetBlogId()
 
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
 	oid, err := primitive.ObjectIDFromHex(blogID)
 
 	if err != nil {
@@ -213,29 +385,59 @@ func (*server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*
 		)
 	}
 
-	filter := bson.D{
-		{
-			Key:   "_id",
-			Value: oid,
-		},
+	data, findErr := s.store.ReadBlog(ctx, oid)
+
+	if findErr == errBlogNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
+		)
 	}
 
-	deleteRes, deleteErr := blogCollection.DeleteOne(context.Background(), filter)
+	if findErr != nil {
+		return nil, storeError(findErr)
+	}
 
-	if deleteErr != nil {
+	if data.AuthorID != userID {
 		return nil, status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Internal error: %v", deleteErr),
+			codes.PermissionDenied,
+			fmt.Sprintf("Blog %s is not by user %v", blogID, userID),
 		)
 	}
 
-	if deleteRes.DeletedCount == 0 {
+	eventID, err := s.prepareEvent(blogDeletedEvent, &blogItem{ID: oid})
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	deleteErr := s.store.DeleteBlog(ctx, oid)
+
+	if deleteErr != nil {
+		s.abandonEvent(eventID)
+	}
+
+	if deleteErr == errBlogNotFound {
 		return nil, status.Errorf(
 			codes.NotFound,
 			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
 		)
 	}
 
+	if deleteErr != nil {
+		return nil, storeError(deleteErr)
+	}
+
+	// Series navigation skips parts that no longer exist, so a failure here
+	// is logged like webhook failures rather than returned.
+	if err := s.series.RemoveBlog(ctx, oid); err != nil {
+		fmt.Printf("Failed to remove blog %v from its series: %v\n", blogID, err)
+	}
+
+	s.related.Remove(oid)
+	s.reactions.Notify(oid)
+	s.confirmEvent(eventID)
+
 	resp := &blogpb.DeleteBlogResponse{
 		BlogId: blogID,
 	}
@@ -243,86 +445,421 @@ func (*server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*
 	return resp, nil
 }
 
-func (*server) ListBlog(req *blogpb.ListBlogRequest, stream blogpb.BlogService_ListBlogServer) error {
+func (s *server) ListBlog(req *blogpb.ListBlogRequest, stream blogpb.BlogService_ListBlogServer) error {
 
 	fmt.Printf("List blog request: %v\n", req)
 
-	filter := bson.D{}
+	afterID := primitive.NilObjectID
+
+	if resumeAfterID := req.GetResumeAfterId(); resumeAfterID != "" {
+		oid, err := primitive.ObjectIDFromHex(resumeAfterID)
+
+		if err != nil {
+			return status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Cannot parse resume ID: %v", resumeAfterID),
+			)
+		}
+
+		afterID = oid
+	}
+
+	ctx := stream.Context()
 
-	cur, err := blogCollection.Find(context.Background(), filter)
+	prefs, err := localePreferences(ctx, req.GetLocale())
 
 	if err != nil {
-		return status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Internal error: %v", err),
-		)
+		return err
 	}
 
-	defer cur.Close(context.Background())
+	var sendErr error
 
-	for cur.Next(context.Background()) {
-		data := &blogItem{}
+	err = s.store.ListBlogs(ctx, afterID, func(data *blogItem) error {
+		if !data.published() {
+			return nil
+		}
 
-		decodeErr := cur.Decode(data)
+		resp := &blogpb.ListBlogResponse{
+			Blog: localize(data, prefs),
+		}
 
-		if decodeErr != nil {
-			return status.Errorf(
-				codes.Internal,
-				fmt.Sprintf("Error while decoding data: %v", decodeErr),
+		sendErr = stream.Send(resp)
+
+		return sendErr
+	})
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		fmt.Printf("List blog stream ended early: %v\n", ctxErr)
+		return status.FromContextError(ctxErr).Err()
+	}
+
+	if sendErr != nil {
+		fmt.Printf("Error while sending blog: %v\n", sendErr)
+		return sendErr
+	}
+
+	if err != nil {
+		return storeError(err)
+	}
+
+	return nil
+}
+
+// maxStatsRange bounds the posts_per_day range of GetBlogStats.
+const maxStatsRange = 366 * 24 * time.Hour
+
+func (s *server) GetBlogStats(ctx context.Context, req *blogpb.GetBlogStatsRequest) (*blogpb.GetBlogStatsResponse, error) {
+
+	fmt.Printf("Get blog stats request: %v\n", req)
+
+	to := time.Now().UTC()
+
+	if req.GetTo() != nil {
+		if err := req.GetTo().CheckValid(); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Invalid to: %v", err),
 			)
 		}
 
-		resp := &blogpb.ListBlogResponse{
-			Blog: &blogpb.Blog{
-				Id:       data.ID.Hex(),
-				AuthorId: data.AuthorID,
-				Title:    data.Title,
-				Content:  data.Content,
-			},
+		to = req.GetTo().AsTime()
+	}
+
+	from := to.Add(-30 * 24 * time.Hour)
+
+	if req.GetFrom() != nil {
+		if err := req.GetFrom().CheckValid(); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Invalid from: %v", err),
+			)
 		}
 
-		stream.Send(resp)
+		from = req.GetFrom().AsTime()
 	}
 
-	if err := cur.Err(); err != nil {
-		return status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Internal error: %v", err),
+	if !from.Before(to) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"from must be before to",
 		)
 	}
 
-	return nil
+	if to.Sub(from) > maxStatsRange {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Range cannot be longer than %v", maxStatsRange),
+		)
+	}
+
+	stats, err := s.store.BlogStats(ctx, from, to)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &blogpb.GetBlogStatsResponse{
+		TotalPosts:      stats.TotalPosts,
+		TotalWords:      stats.TotalWords,
+		TotalCharacters: stats.TotalCharacters,
+	}
+
+	for authorID, posts := range stats.PostsPerAuthor {
+		resp.PostsPerAuthor = append(resp.PostsPerAuthor, &blogpb.AuthorPostCount{
+			AuthorId: authorID,
+			Posts:    posts,
+		})
+	}
+
+	sort.Slice(resp.PostsPerAuthor, func(i, j int) bool {
+		a, b := resp.PostsPerAuthor[i], resp.PostsPerAuthor[j]
+
+		if a.Posts != b.Posts {
+			return a.Posts > b.Posts
+		}
+
+		return a.AuthorId < b.AuthorId
+	})
+
+	// Report every day in the range, including days without posts.
+	lastDay := to.Add(-time.Nanosecond).UTC().Format(dayLayout)
+
+	for day := from.UTC(); ; day = day.AddDate(0, 0, 1) {
+		date := day.Format(dayLayout)
+
+		resp.PostsPerDay = append(resp.PostsPerDay, &blogpb.DailyPostCount{
+			Date:  date,
+			Posts: stats.PostsPerDay[date],
+		})
+
+		if date >= lastDay {
+			break
+		}
+	}
+
+	return resp, nil
+}
+
+const (
+	defaultRelatedLimit = 5
+	maxRelatedLimit     = 50
+)
+
+func (s *server) GetRelatedBlogs(ctx context.Context, req *blogpb.GetRelatedBlogsRequest) (*blogpb.GetRelatedBlogsResponse, error) {
+
+	fmt.Printf("Get related blogs request: %v\n", req)
+
+	blogID := req.GetBlogId()
+
+	oid, err := primitive.ObjectIDFromHex(blogID)
+
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot parse ID",
+		)
+	}
+
+	limit := int(req.GetLimit())
+
+	if limit < 0 || limit > maxRelatedLimit {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Limit must be between 0 and %d", maxRelatedLimit),
+		)
+	}
+
+	if limit == 0 {
+		limit = defaultRelatedLimit
+	}
+
+	source, err := s.store.ReadBlog(ctx, oid)
+
+	if err == errBlogNotFound || (err == nil && !source.published()) {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	results, ok := s.related.Related(oid, limit)
+
+	if !ok {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
+		)
+	}
+
+	resp := &blogpb.GetRelatedBlogsResponse{}
+
+	for _, result := range results {
+		data, err := s.store.ReadBlog(ctx, result.ID)
+
+		if err == errBlogNotFound {
+			// Deleted since the index was queried.
+			continue
+		}
+
+		if err != nil {
+			return nil, storeError(err)
+		}
+
+		// Quarantined since the index was queried.
+		if !data.published() {
+			continue
+		}
+
+		resp.Related = append(resp.Related, &blogpb.RelatedBlog{
+			Blog:  blogItemToPb(data),
+			Score: result.Score,
+		})
+	}
+
+	return resp, nil
 }
 
 func main() {
 	// If we crash the go code, we get the file name and line number
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 
-	fmt.Println("Blog Server Started")
+	storeBackend := flag.String("store", "mongo", "Blog storage backend: mongo or memory")
+	cacheEnabled := flag.Bool("cache", true, "Cache ReadBlog results in memory")
+	cacheSize := flag.Int("cache-size", 1000, "Maximum number of blogs held in the ReadBlog cache")
+	cacheTTL := flag.Duration("cache-ttl", time.Minute, "How long a cached blog is served before it is read again")
+	cacheNegativeTTL := flag.Duration("cache-negative-ttl", 5*time.Second, "How long a NotFound result is cached")
+	httpAddr := flag.String("http-addr", ":8080", "Address of the HTTP server for feeds and blog pages, empty to disable it")
+	siteURL := flag.String("site-url", "http://localhost:8080", "Public base URL of the HTTP server, used for links in feeds and pages")
+	moderationConfig := flag.String("moderation-config", "", "JSON file configuring the moderation filters, empty to publish every blog")
+	authSecret := flag.String("auth-secret", "", "Key that user tokens are signed with, defaults to $BLOG_AUTH_SECRET")
+	operators := flag.String("operators", "", "Comma-separated IDs of the users allowed to call WebhookService")
+	moderators := flag.String("moderators", "", "Comma-separated IDs of the users allowed to call ModerationService")
+	feedSize := flag.Int("feed-size", 20, fmt.Sprintf("Maximum number of entries in a feed (at most %d)", maxFeedSize))
+
+	mongoCfg := mongoConfig{}
+
+	flag.StringVar(&mongoCfg.URI, "mongo-uri", "", "MongoDB connection string, defaults to $BLOG_MONGO_URI or "+defaultMongoURI)
+	flag.StringVar(&mongoCfg.Database, "mongo-database", "grpc-go-course", "MongoDB database")
+	flag.StringVar(&mongoCfg.Collection, "mongo-collection", "blog", "MongoDB collection holding the blogs")
+	flag.DurationVar(&mongoCfg.ConnectTimeout, "mongo-connect-timeout", 10*time.Second, "How long to wait for a MongoDB server before an operation fails as unavailable")
+	flag.DurationVar(&mongoCfg.OperationTimeout, "mongo-operation-timeout", 30*time.Second, "Maximum duration of a single MongoDB read or write")
+	flag.DurationVar(&mongoCfg.StartupTimeout, "mongo-startup-timeout", time.Minute, "How long to retry connecting to MongoDB at startup")
+	flag.DurationVar(&mongoCfg.PingInterval, "mongo-ping-interval", 30*time.Second, "How often to check the MongoDB connection, 0 to disable")
+
+	flag.Parse()
+
+	if *feedSize < 1 || *feedSize > maxFeedSize {
+		log.Fatalf("feed-size must be between 1 and %d", maxFeedSize)
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	if mongoCfg.ConnectTimeout <= 0 {
+		log.Fatalf("mongo-connect-timeout must be positive")
+	}
 
-	defer cancel()
+	if mongoCfg.OperationTimeout <= 0 {
+		log.Fatalf("mongo-operation-timeout must be positive")
+	}
 
-	fmt.Println("Connecting to Mongo")
+	if mongoCfg.StartupTimeout <= 0 {
+		log.Fatalf("mongo-startup-timeout must be positive")
+	}
+
+	if *authSecret == "" {
+		*authSecret = os.Getenv("BLOG_AUTH_SECRET")
+	}
 
-	mongoClient, err := mongo.Connect(ctx, options.Client().ApplyURI("mongodb://localhost:27017"))
+	// Like the auth secret, the URI may hold credentials and is not shown as
+	// a flag default.
+	if mongoCfg.URI == "" {
+		mongoCfg.URI = os.Getenv("BLOG_MONGO_URI")
+	}
+
+	if mongoCfg.URI == "" {
+		mongoCfg.URI = defaultMongoURI
+	}
+
+	if flag.Arg(0) == "migrate" {
+		os.Exit(migrate(mongoCfg, flag.Args()[1:]))
+	}
+
+	fmt.Println("Blog Server Started")
+
+	moderation, err := loadModeration(*moderationConfig)
 
 	if err != nil {
-		log.Fatalf("Failed to connect to mongo: %v", err)
+		log.Fatalf("Failed to load moderation config: %v", err)
 	}
 
-	defer func() {
-		fmt.Println("Closing Mongo connection")
+	fmt.Printf("Loaded %d moderation filters\n", len(moderation))
+
+	var store blogStore
+	var webhookStore webhookStore
+	var readingListStore readingListStore
+	var seriesStore seriesStore
+
+	switch *storeBackend {
+	case "memory":
+		fmt.Println("Using in-memory blog store")
+
+		store = newMemoryStore()
+		webhookStore = newMemoryWebhookStore()
+		readingListStore = newMemoryReadingListStore()
+		seriesStore = newMemorySeriesStore()
+	case "mongo":
+		fmt.Println("Connecting to Mongo")
 
-		if err = mongoClient.Disconnect(ctx); err != nil {
-			panic(err)
+		mongoClient, err := connectMongo(mongoCfg)
+
+		if err != nil {
+			log.Fatalf("Failed to connect to mongo: %v", err)
 		}
-	}()
 
-	fmt.Println("Connected to MongoDB")
+		// Registered first so it runs last, after everything using the
+		// connection has stopped. The startup context has long expired by
+		// then.
+		defer func() {
+			fmt.Println("Closing Mongo connection")
+
+			ctx, cancel := context.WithTimeout(context.Background(), mongoCfg.ConnectTimeout)
+
+			defer cancel()
+
+			if err := mongoClient.Disconnect(ctx); err != nil {
+				fmt.Printf("Failed to close Mongo connection: %v\n", err)
+			}
+		}()
+
+		fmt.Println("Connected to MongoDB")
+
+		if mongoCfg.PingInterval > 0 {
+			monitorCtx, stopMonitor := context.WithCancel(context.Background())
+
+			defer stopMonitor()
+
+			go monitorMongo(monitorCtx, mongoClient, mongoCfg.PingInterval, mongoCfg.ConnectTimeout)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), mongoCfg.StartupTimeout)
+
+		defer cancel()
+
+		db := mongoClient.Database(mongoCfg.Database)
+
+		pending, err := pendingMigrations(ctx, db)
+
+		if err != nil {
+			log.Fatalf("Failed to read migrations: %v", err)
+		}
+
+		if pending > 0 {
+			fmt.Printf("%d migrations pending, run \"blog_server migrate up\"\n", pending)
+		}
+
+		mongoStore := newMongoStore(db, mongoCfg.Collection)
+		mongoWebhookStore := newMongoWebhookStore(db)
+		mongoReadingListStore := newMongoReadingListStore(db)
+		mongoSeriesStore := newMongoSeriesStore(db)
+
+		fmt.Println("Creating indexes")
+
+		for _, indexed := range []interface {
+			EnsureIndexes(ctx context.Context) error
+		}{mongoStore, mongoWebhookStore, mongoReadingListStore, mongoSeriesStore} {
+			if err := indexed.EnsureIndexes(ctx); err != nil {
+				log.Fatalf("Failed to create indexes: %v", err)
+			}
+		}
+
+		store = mongoStore
+		webhookStore = mongoWebhookStore
+		readingListStore = mongoReadingListStore
+		seriesStore = mongoSeriesStore
+	default:
+		log.Fatalf("Unknown store backend: %v", *storeBackend)
+	}
+
+	// The dispatcher checks the writes of unconfirmed events, which must not
+	// come from the cache.
+	webhooks := newWebhookDispatcher(webhookStore, store)
+
+	var cache *cachedStore
+
+	if *cacheEnabled {
+		cache = newCachedStore(store, *cacheSize, *cacheTTL, *cacheNegativeTTL)
+		store = cache
+	}
+
+	related := newRelatedIndex()
+
+	fmt.Println("Building related blogs index")
 
-	blogCollection = mongoClient.Database("grpc-go-course").Collection("blog")
+	if err := related.Load(context.Background(), store); err != nil {
+		log.Fatalf("Failed to build related blogs index: %v", err)
+	}
 
 	lis, err := net.Listen("tcp", ":50051")
 
@@ -348,12 +885,86 @@ func main() {
 		opts = append(opts, grpc.Creds(creds))
 	}
 
+	webhooksCtx, stopWebhooks := context.WithCancel(context.Background())
+	webhooksDone := make(chan struct{})
+
+	go func() {
+		webhooks.Run(webhooksCtx)
+		close(webhooksDone)
+	}()
+
+	views := newViewCounter(store)
+
+	viewsCtx, stopViews := context.WithCancel(context.Background())
+	viewsDone := make(chan struct{})
+
+	go func() {
+		views.Run(viewsCtx)
+		close(viewsDone)
+	}()
+
 	s := grpc.NewServer(opts...)
 
-	blogpb.RegisterBlogServiceServer(s, &server{})
+	auth := newAuthenticator(*authSecret)
+
+	blogServer := &server{
+		store:      store,
+		related:    related,
+		webhooks:   webhooks,
+		reactions:  newReactionHub(),
+		views:      views,
+		moderation: moderation,
+		series:     seriesStore,
+		bookmarks:  readingListStore,
+		auth:       auth,
+	}
+
+	blogpb.RegisterBlogServiceServer(s, blogServer)
+	blogpb.RegisterModerationServiceServer(s, &moderationServer{
+		blogs:      blogServer,
+		auth:       auth,
+		moderators: parseUserSet(*moderators),
+	})
+	blogpb.RegisterReadingListServiceServer(s, &readingListServer{
+		store: readingListStore,
+		blogs: store,
+		auth:  auth,
+	})
+	blogpb.RegisterSeriesServiceServer(s, &seriesServer{
+		store: seriesStore,
+		blogs: store,
+		auth:  auth,
+	})
+	blogpb.RegisterWebhookServiceServer(s, &webhookServer{
+		store:      webhookStore,
+		dispatcher: webhooks,
+		auth:       auth,
+		operators:  parseUserSet(*operators),
+	})
 
 	reflection.Register(s)
 
+	var httpServer *http.Server
+
+	if *httpAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/feeds/", newFeedHandler(store, *siteURL, *feedSize))
+		mux.Handle("/", newPageHandler(store, *siteURL, *feedSize))
+
+		httpServer = &http.Server{
+			Addr:    *httpAddr,
+			Handler: mux,
+		}
+
+		go func() {
+			fmt.Printf("Starting HTTP server on %v\n", *httpAddr)
+
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("failed to serve HTTP: %v", err)
+			}
+		}()
+	}
+
 	go func() {
 		fmt.Println("Starting Server")
 
@@ -372,8 +983,50 @@ func main() {
 
 	fmt.Println("Stopping the server")
 	s.Stop()
+
+	if httpServer != nil {
+		fmt.Println("Stopping the HTTP server")
+
+		shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 5*time.Second)
+		httpServer.Shutdown(shutdownCtx)
+		cancelShutdown()
+	}
 	fmt.Println("Closing the listener")
 	lis.Close()
 
+	fmt.Println("Waiting for webhook deliveries")
+	stopWebhooks()
+	<-webhooksDone
+
+	fmt.Println("Flushing view counts")
+	stopViews()
+	<-viewsDone
+
+	if cache != nil {
+		hits, misses := cache.Stats()
+		fmt.Printf("Blog cache: %d hits, %d misses\n", hits, misses)
+	}
+
 	fmt.Println("Server stopped")
 }
+
+// migrate runs the migrate subcommand and returns the process exit code.
+func migrate(cfg mongoConfig, args []string) int {
+	mongoClient, err := connectMongo(cfg)
+
+	if err != nil {
+		fmt.Printf("Failed to connect to mongo: %v\n", err)
+		return 1
+	}
+
+	defer mongoClient.Disconnect(context.Background())
+
+	blogs := mongoClient.Database(cfg.Database).Collection(cfg.Collection)
+
+	if err := runMigrate(context.Background(), blogs, args); err != nil {
+		fmt.Printf("Migration failed: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// dayLayout is the format used for the per-day post counts.
+const dayLayout = "2006-01-02"
+
+// blogStats holds the aggregated numbers returned by GetBlogStats.
+// PostsPerDay is keyed by UTC day in dayLayout and only covers the requested
+// range.
+type blogStats struct {
+	TotalPosts      int64
+	TotalWords      int64
+	TotalCharacters int64
+	PostsPerAuthor  map[string]int64
+	PostsPerDay     map[string]int64
+}
+
+func newBlogStats() *blogStats {
+	return &blogStats{
+		PostsPerAuthor: make(map[string]int64),
+		PostsPerDay:    make(map[string]int64),
+	}
+}
+
+// countWords returns the number of whitespace separated words in the title and
+// content of a blog.
+func countWords(data *blogItem) int64 {
+	return int64(len(strings.Fields(data.Title)) + len(strings.Fields(data.Content)))
+}
+
+// countCharacters returns the number of characters (not bytes) in the title
+// and content of a blog.
+func countCharacters(data *blogItem) int64 {
+	return int64(utf8.RuneCountInString(data.Title) + utf8.RuneCountInString(data.Content))
+}
+
+// aggregateBlogStats computes blogStats in process by walking every published
+// blog in the store. It is used by backends that cannot aggregate natively.
+// A blog's creation time is taken from its ObjectID.
+func aggregateBlogStats(ctx context.Context, store blogStore, from time.Time, to time.Time) (*blogStats, error) {
+	stats := newBlogStats()
+
+	err := store.ListBlogs(ctx, primitive.NilObjectID, func(data *blogItem) error {
+		if !data.published() {
+			return nil
+		}
+
+		stats.TotalPosts++
+		stats.TotalWords += countWords(data)
+		stats.TotalCharacters += countCharacters(data)
+		stats.PostsPerAuthor[data.AuthorID]++
+
+		createdAt := data.ID.Timestamp()
+
+		if !createdAt.Before(from) && createdAt.Before(to) {
+			stats.PostsPerDay[createdAt.UTC().Format(dayLayout)]++
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBlogStatsCountsOnlyPublishedBlogs(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+
+	for _, data := range []*blogItem{
+		{AuthorID: "alice", Title: "one", Content: "two words"},
+		{AuthorID: "bob", Title: "held", Content: "spam", Status: blogStatusQuarantined},
+		{AuthorID: "bob", Title: "gone", Content: "spam", Status: blogStatusRejected},
+	} {
+		if _, err := store.CreateBlog(ctx, data); err != nil {
+			t.Fatalf("CreateBlog: %v", err)
+		}
+	}
+
+	now := time.Now()
+
+	stats, err := store.BlogStats(ctx, now.Add(-time.Hour), now.Add(time.Hour))
+
+	if err != nil {
+		t.Fatalf("BlogStats: %v", err)
+	}
+
+	if stats.TotalPosts != 1 || stats.TotalWords != 3 || stats.TotalCharacters != 12 {
+		t.Errorf("got %d posts, %d words, %d characters, want 1, 3, 12", stats.TotalPosts, stats.TotalWords, stats.TotalCharacters)
+	}
+
+	if len(stats.PostsPerAuthor) != 1 || stats.PostsPerAuthor["alice"] != 1 {
+		t.Errorf("got posts per author %v, want only alice", stats.PostsPerAuthor)
+	}
+
+	var days int64
+
+	for _, posts := range stats.PostsPerDay {
+		days += posts
+	}
+
+	if days != 1 {
+		t.Errorf("got %d posts per day, want 1", days)
+	}
+}
@@ -0,0 +1,776 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// errBlogNotFound is returned by a blogStore when no blog matches the given ID.
+var errBlogNotFound = errors.New("blog not found")
+
+// blogStore persists blog items. Implementations return errBlogNotFound when
+// the requested blog does not exist.
+type blogStore interface {
+	CreateBlog(ctx context.Context, data *blogItem) (primitive.ObjectID, error)
+	ReadBlog(ctx context.Context, id primitive.ObjectID) (*blogItem, error)
+	// ReadBlogs returns the blogs among ids that exist, keyed by ID.
+	ReadBlogs(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*blogItem, error)
+	// UpdateBlog saves the editable fields of a blog. Its translations are
+	// kept, they are only changed through SetTranslation.
+	UpdateBlog(ctx context.Context, data *blogItem) error
+	// SetTranslation saves the translation of a blog to tag, or removes it if
+	// translation is nil, along with the moderation status, reasons and update
+	// time of data. It returns errBlogStatusChanged if the blog was updated
+	// since data was read, as the status then no longer covers every
+	// translation.
+	SetTranslation(ctx context.Context, data *blogItem, tag string, translation *blogTranslation) error
+	DeleteBlog(ctx context.Context, id primitive.ObjectID) error
+	// ListBlogs calls fn for every blog in ascending ID order, starting after
+	// afterID unless it is the nil ObjectID. Iteration stops at the first
+	// error returned by fn or when ctx is done.
+	ListBlogs(ctx context.Context, afterID primitive.ObjectID, fn func(data *blogItem) error) error
+	// LatestBlogs returns up to limit published blogs, newest first. An empty
+	// authorID matches every author.
+	LatestBlogs(ctx context.Context, authorID string, limit int) ([]*blogItem, error)
+	// BlogStats aggregates statistics over published blogs. Posts per day are
+	// only counted for blogs created in [from, to).
+	BlogStats(ctx context.Context, from time.Time, to time.Time) (*blogStats, error)
+	// AddReaction records a reaction of a user to a blog and returns the
+	// blog's reaction counts. changed is false if the user had already
+	// reacted with that type.
+	AddReaction(ctx context.Context, blogID primitive.ObjectID, userID string, reaction string) (counts map[string]int64, changed bool, err error)
+	// RemoveReaction is the inverse of AddReaction. changed is false if the
+	// user had not reacted with that type.
+	RemoveReaction(ctx context.Context, blogID primitive.ObjectID, userID string, reaction string) (counts map[string]int64, changed bool, err error)
+	// RecordViews adds view counts to the stored per-bucket counts.
+	RecordViews(ctx context.Context, counts []viewCount) error
+	// TrendingBlogs returns up to limit blogs ordered by their views since the
+	// given time, decayed by age relative to now.
+	TrendingBlogs(ctx context.Context, now time.Time, since time.Time, halfLife time.Duration, limit int) ([]trendingScore, error)
+	// BlogsWithStatus returns up to limit blogs with the given moderation
+	// status in ascending ID order, starting after afterID unless it is the
+	// nil ObjectID.
+	BlogsWithStatus(ctx context.Context, status string, afterID primitive.ObjectID, limit int) ([]*blogItem, error)
+	// SetBlogStatus changes the moderation status and reasons of a blog. It
+	// returns errBlogStatusChanged if the blog's status is no longer from or
+	// the blog was updated since revision.
+	SetBlogStatus(ctx context.Context, id primitive.ObjectID, from string, revision int64, to string, reasons []string) error
+}
+
+// mongoStore is a blogStore backed by MongoDB. Blogs live in one collection
+// and individual reactions in another, with the per-blog reaction counts kept
+// on the blog document.
+type mongoStore struct {
+	collection *mongo.Collection
+	reactions  *mongo.Collection
+	views      *mongo.Collection
+}
+
+// reactionItem records that a user reacted to a blog with one reaction type.
+type reactionItem struct {
+	BlogID    primitive.ObjectID `bson:"blog_id"`
+	UserID    string             `bson:"user_id"`
+	Type      string             `bson:"type"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// newMongoStore keeps blogs in the named collection of db. Reactions and
+// views use fixed collections next to it.
+func newMongoStore(db *mongo.Database, collection string) *mongoStore {
+	return &mongoStore{
+		collection: db.Collection(collection),
+		reactions:  db.Collection("reactions"),
+		views:      db.Collection("blog_views"),
+	}
+}
+
+// EnsureIndexes creates the indexes the store relies on.
+func (m *mongoStore) EnsureIndexes(ctx context.Context) error {
+	// One reaction of each type per user and blog.
+	_, err := m.reactions.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "blog_id", Value: 1},
+			{Key: "user_id", Value: 1},
+			{Key: "type", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// Per-author feeds list an author's newest blogs.
+	_, err = m.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "author_id", Value: 1},
+			{Key: "_id", Value: -1},
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// The moderation queue lists blogs by status.
+	_, err = m.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "status", Value: 1},
+			{Key: "_id", Value: 1},
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = m.views.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "blog_id", Value: 1},
+				{Key: "bucket", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "bucket", Value: 1}},
+		},
+	})
+
+	return err
+}
+
+func idFilter(id primitive.ObjectID) bson.D {
+	return bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	}
+}
+
+// revisionFilter matches blogs not updated since revision. Blogs never
+// updated have no revision.
+func revisionFilter(revision int64) bson.E {
+	if revision == 0 {
+		return bson.E{Key: "revision", Value: bson.D{{Key: "$exists", Value: false}}}
+	}
+
+	return bson.E{Key: "revision", Value: revision}
+}
+
+func (m *mongoStore) CreateBlog(ctx context.Context, data *blogItem) (primitive.ObjectID, error) {
+	res, err := m.collection.InsertOne(ctx, data)
+
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+
+	if !ok {
+		return primitive.NilObjectID, errors.New("cannot convert to OID")
+	}
+
+	return oid, nil
+}
+
+func (m *mongoStore) ReadBlog(ctx context.Context, id primitive.ObjectID) (*blogItem, error) {
+	data := &blogItem{}
+
+	err := m.collection.FindOne(ctx, idFilter(id)).Decode(data)
+
+	if err == mongo.ErrNoDocuments {
+		return nil, errBlogNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (m *mongoStore) ReadBlogs(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*blogItem, error) {
+	cur, err := m.collection.Find(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}})
+
+	if err != nil {
+		return nil, err
+	}
+
+	found := []*blogItem{}
+
+	if err := cur.All(ctx, &found); err != nil {
+		return nil, err
+	}
+
+	blogs := make(map[primitive.ObjectID]*blogItem, len(found))
+
+	for _, data := range found {
+		blogs[data.ID] = data
+	}
+
+	return blogs, nil
+}
+
+func (m *mongoStore) UpdateBlog(ctx context.Context, data *blogItem) error {
+	// Only set the editable fields, counters are updated concurrently with
+	// $inc and must not be overwritten by a stale copy.
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "author_id", Value: data.AuthorID},
+		{Key: "title", Value: data.Title},
+		{Key: "content", Value: data.Content},
+		{Key: "tags", Value: data.Tags},
+		{Key: "schema_version", Value: data.SchemaVersion},
+		{Key: "updated_at", Value: data.UpdatedAt},
+		{Key: "status", Value: data.Status},
+		{Key: "moderation_reasons", Value: data.ModerationReasons},
+		{Key: "language", Value: data.Language},
+	}}, {Key: "$unset", Value: bson.D{{Key: swappedMarker, Value: ""}}}, {Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}}}
+
+	res, err := m.collection.UpdateOne(ctx, idFilter(data.ID), update)
+
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return errBlogNotFound
+	}
+
+	return nil
+}
+
+func (m *mongoStore) SetTranslation(ctx context.Context, data *blogItem, tag string, translation *blogTranslation) error {
+	filter := append(idFilter(data.ID), revisionFilter(data.Revision))
+
+	// Only the one translation is written, so the others are never replaced
+	// by a stale copy.
+	set := bson.D{
+		{Key: "schema_version", Value: data.SchemaVersion},
+		{Key: "updated_at", Value: data.UpdatedAt},
+		{Key: "status", Value: data.Status},
+		{Key: "moderation_reasons", Value: data.ModerationReasons},
+	}
+	update := bson.D{}
+
+	if translation != nil {
+		set = append(set, bson.E{Key: "translations." + tag, Value: translation})
+	} else {
+		update = append(update, bson.E{Key: "$unset", Value: bson.D{{Key: "translations." + tag, Value: ""}}})
+	}
+
+	update = append(update,
+		bson.E{Key: "$set", Value: set},
+		bson.E{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}},
+	)
+
+	res, err := m.collection.UpdateOne(ctx, filter, update)
+
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount > 0 {
+		return nil
+	}
+
+	// Tell a missing blog apart from one that changed.
+	if _, err := m.ReadBlog(ctx, data.ID); err != nil {
+		return err
+	}
+
+	return errBlogStatusChanged
+}
+
+func (m *mongoStore) DeleteBlog(ctx context.Context, id primitive.ObjectID) error {
+	res, err := m.collection.DeleteOne(ctx, idFilter(id))
+
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return errBlogNotFound
+	}
+
+	// The blog is gone, so the caller must go on as if it was deleted.
+	// Reactions and views left behind are never read again, failing to
+	// remove them is only logged.
+	if _, err := m.reactions.DeleteMany(ctx, bson.D{{Key: "blog_id", Value: id}}); err != nil {
+		fmt.Printf("Failed to delete the reactions of blog %v: %v\n", id.Hex(), err)
+	}
+
+	if _, err := m.views.DeleteMany(ctx, bson.D{{Key: "blog_id", Value: id}}); err != nil {
+		fmt.Printf("Failed to delete the views of blog %v: %v\n", id.Hex(), err)
+	}
+
+	return nil
+}
+
+func (m *mongoStore) ListBlogs(ctx context.Context, afterID primitive.ObjectID, fn func(data *blogItem) error) error {
+	filter := bson.D{}
+
+	if !afterID.IsZero() {
+		filter = bson.D{
+			{
+				Key:   "_id",
+				Value: bson.D{{Key: "$gt", Value: afterID}},
+			},
+		}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	cur, err := m.collection.Find(ctx, filter, opts)
+
+	if err != nil {
+		return err
+	}
+
+	// Close with a fresh context so the server-side cursor is killed even
+	// when ctx has been cancelled.
+	defer cur.Close(context.Background())
+
+	for cur.Next(ctx) {
+		data := &blogItem{}
+
+		if err := cur.Decode(data); err != nil {
+			return err
+		}
+
+		if err := fn(data); err != nil {
+			return err
+		}
+	}
+
+	return cur.Err()
+}
+
+// publishedFilter matches published blogs, including those saved before
+// moderation existed, which have no status.
+func publishedFilter() bson.E {
+	return bson.E{
+		Key:   "status",
+		Value: bson.D{{Key: "$in", Value: bson.A{nil, blogStatusPublished}}},
+	}
+}
+
+func (m *mongoStore) LatestBlogs(ctx context.Context, authorID string, limit int) ([]*blogItem, error) {
+	filter := bson.D{publishedFilter()}
+
+	if authorID != "" {
+		filter = append(filter, bson.E{Key: "author_id", Value: authorID})
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cur, err := m.collection.Find(ctx, filter, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blogs := []*blogItem{}
+
+	if err := cur.All(ctx, &blogs); err != nil {
+		return nil, err
+	}
+
+	return blogs, nil
+}
+
+func (m *mongoStore) BlogStats(ctx context.Context, from time.Time, to time.Time) (*blogStats, error) {
+	stats := newBlogStats()
+
+	// Missing fields would make $strLenCP fail, treat them as empty strings.
+	title := bson.D{{Key: "$ifNull", Value: bson.A{"$title", ""}}}
+	content := bson.D{{Key: "$ifNull", Value: bson.A{"$content", ""}}}
+
+	wordCount := func(field bson.D) bson.D {
+		return bson.D{{Key: "$size", Value: bson.D{{Key: "$regexFindAll", Value: bson.D{
+			{Key: "input", Value: field},
+			{Key: "regex", Value: `\S+`},
+		}}}}}
+	}
+
+	published := bson.D{{Key: "$match", Value: bson.D{publishedFilter()}}}
+
+	totalsPipeline := mongo.Pipeline{
+		published,
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: nil},
+			{Key: "posts", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "words", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$add", Value: bson.A{
+				wordCount(title),
+				wordCount(content),
+			}}}}}},
+			{Key: "characters", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$add", Value: bson.A{
+				bson.D{{Key: "$strLenCP", Value: title}},
+				bson.D{{Key: "$strLenCP", Value: content}},
+			}}}}}},
+		}}},
+	}
+
+	var totals []struct {
+		Posts      int64 `bson:"posts"`
+		Words      int64 `bson:"words"`
+		Characters int64 `bson:"characters"`
+	}
+
+	if err := aggregate(ctx, m.collection, totalsPipeline, &totals); err != nil {
+		return nil, err
+	}
+
+	if len(totals) > 0 {
+		stats.TotalPosts = totals[0].Posts
+		stats.TotalWords = totals[0].Words
+		stats.TotalCharacters = totals[0].Characters
+	}
+
+	authorsPipeline := mongo.Pipeline{
+		published,
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$author_id"},
+			{Key: "posts", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+
+	var authors []struct {
+		AuthorID string `bson:"_id"`
+		Posts    int64  `bson:"posts"`
+	}
+
+	if err := aggregate(ctx, m.collection, authorsPipeline, &authors); err != nil {
+		return nil, err
+	}
+
+	for _, author := range authors {
+		stats.PostsPerAuthor[author.AuthorID] = author.Posts
+	}
+
+	// ObjectIDs start with their creation time, so the range can be matched
+	// on _id and the day derived from it.
+	daysPipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			publishedFilter(),
+			{Key: "_id", Value: bson.D{
+				{Key: "$gte", Value: primitive.NewObjectIDFromTimestamp(from)},
+				{Key: "$lt", Value: primitive.NewObjectIDFromTimestamp(to)},
+			}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$dateToString", Value: bson.D{
+				{Key: "format", Value: "%Y-%m-%d"},
+				{Key: "date", Value: bson.D{{Key: "$toDate", Value: "$_id"}}},
+			}}}},
+			{Key: "posts", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+
+	var days []struct {
+		Day   string `bson:"_id"`
+		Posts int64  `bson:"posts"`
+	}
+
+	if err := aggregate(ctx, m.collection, daysPipeline, &days); err != nil {
+		return nil, err
+	}
+
+	for _, day := range days {
+		stats.PostsPerDay[day.Day] = day.Posts
+	}
+
+	return stats, nil
+}
+
+func aggregate(ctx context.Context, collection *mongo.Collection, pipeline mongo.Pipeline, results interface{}) error {
+	cur, err := collection.Aggregate(ctx, pipeline)
+
+	if err != nil {
+		return err
+	}
+
+	return cur.All(ctx, results)
+}
+
+func (m *mongoStore) AddReaction(ctx context.Context, blogID primitive.ObjectID, userID string, reaction string) (map[string]int64, bool, error) {
+	_, err := m.reactions.InsertOne(ctx, reactionItem{
+		BlogID:    blogID,
+		UserID:    userID,
+		Type:      reaction,
+		CreatedAt: time.Now().UTC(),
+	})
+
+	if mongo.IsDuplicateKeyError(err) {
+		counts, err := m.reactionCounts(ctx, blogID)
+
+		return counts, false, err
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	counts, err := m.incrementReaction(ctx, blogID, reaction, 1)
+
+	if err == errBlogNotFound {
+		// The blog does not exist, drop the reaction we just recorded.
+		m.reactions.DeleteOne(ctx, reactionFilter(blogID, userID, reaction))
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return counts, true, nil
+}
+
+func (m *mongoStore) RemoveReaction(ctx context.Context, blogID primitive.ObjectID, userID string, reaction string) (map[string]int64, bool, error) {
+	res, err := m.reactions.DeleteOne(ctx, reactionFilter(blogID, userID, reaction))
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if res.DeletedCount == 0 {
+		counts, err := m.reactionCounts(ctx, blogID)
+
+		return counts, false, err
+	}
+
+	counts, err := m.incrementReaction(ctx, blogID, reaction, -1)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return counts, true, nil
+}
+
+func reactionFilter(blogID primitive.ObjectID, userID string, reaction string) bson.D {
+	return bson.D{
+		{Key: "blog_id", Value: blogID},
+		{Key: "user_id", Value: userID},
+		{Key: "type", Value: reaction},
+	}
+}
+
+func (m *mongoStore) incrementReaction(ctx context.Context, blogID primitive.ObjectID, reaction string, delta int64) (map[string]int64, error) {
+	update := bson.D{{Key: "$inc", Value: bson.D{{Key: "reaction_counts." + reaction, Value: delta}}}}
+	opts := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.D{{Key: "reaction_counts", Value: 1}})
+
+	data := &blogItem{}
+
+	err := m.collection.FindOneAndUpdate(ctx, idFilter(blogID), update, opts).Decode(data)
+
+	if err == mongo.ErrNoDocuments {
+		return nil, errBlogNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data.ReactionCounts, nil
+}
+
+func (m *mongoStore) reactionCounts(ctx context.Context, blogID primitive.ObjectID) (map[string]int64, error) {
+	opts := options.FindOne().SetProjection(bson.D{{Key: "reaction_counts", Value: 1}})
+
+	data := &blogItem{}
+
+	err := m.collection.FindOne(ctx, idFilter(blogID), opts).Decode(data)
+
+	if err == mongo.ErrNoDocuments {
+		return nil, errBlogNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data.ReactionCounts, nil
+}
+
+// RecordViews skips the counts of blogs that no longer exist, like the memory
+// store, so that they do not leave buckets behind after DeleteBlog.
+func (m *mongoStore) RecordViews(ctx context.Context, counts []viewCount) error {
+	ids := make([]primitive.ObjectID, 0, len(counts))
+
+	for _, c := range counts {
+		ids = append(ids, c.BlogID)
+	}
+
+	opts := options.Find().SetProjection(bson.D{{Key: "_id", Value: 1}})
+
+	cur, err := m.collection.Find(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}}, opts)
+
+	if err != nil {
+		return err
+	}
+
+	existing := []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}{}
+
+	if err := cur.All(ctx, &existing); err != nil {
+		return err
+	}
+
+	exists := make(map[primitive.ObjectID]bool, len(existing))
+
+	for _, blog := range existing {
+		exists[blog.ID] = true
+	}
+
+	models := make([]mongo.WriteModel, 0, len(counts))
+
+	for _, c := range counts {
+		if !exists[c.BlogID] {
+			continue
+		}
+
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{
+				{Key: "blog_id", Value: c.BlogID},
+				{Key: "bucket", Value: c.Bucket},
+			}).
+			SetUpdate(bson.D{{Key: "$inc", Value: bson.D{{Key: "count", Value: c.Count}}}}).
+			SetUpsert(true))
+	}
+
+	if len(models) == 0 {
+		return nil
+	}
+
+	_, err = m.views.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+	return err
+}
+
+func (m *mongoStore) TrendingBlogs(ctx context.Context, now time.Time, since time.Time, halfLife time.Duration, limit int) ([]trendingScore, error) {
+	// Subtracting two dates yields milliseconds.
+	decay := -math.Ln2 / float64(halfLife.Milliseconds())
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "bucket", Value: bson.D{{Key: "$gte", Value: since}}}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$blog_id"},
+			{Key: "views", Value: bson.D{{Key: "$sum", Value: "$count"}}},
+			{Key: "score", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$multiply", Value: bson.A{
+				"$count",
+				bson.D{{Key: "$exp", Value: bson.D{{Key: "$multiply", Value: bson.A{
+					decay,
+					bson.D{{Key: "$subtract", Value: bson.A{now, "$bucket"}}},
+				}}}}},
+			}}}}}},
+		}}},
+		// A blog deleted while its views were being written can still have
+		// buckets, and hidden blogs are not shown. Both are dropped before
+		// they take a place in the limit.
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: m.collection.Name()},
+			{Key: "let", Value: bson.D{{Key: "blog_id", Value: "$_id"}}},
+			{Key: "pipeline", Value: mongo.Pipeline{
+				{{Key: "$match", Value: bson.D{
+					{Key: "$expr", Value: bson.D{{Key: "$eq", Value: bson.A{"$_id", "$$blog_id"}}}},
+					publishedFilter(),
+				}}},
+				{{Key: "$project", Value: bson.D{{Key: "_id", Value: 1}}}},
+			}},
+			{Key: "as", Value: "blog"},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "blog", Value: bson.D{{Key: "$ne", Value: bson.A{}}}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "score", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	var results []struct {
+		BlogID primitive.ObjectID `bson:"_id"`
+		Views  int64              `bson:"views"`
+		Score  float64            `bson:"score"`
+	}
+
+	if err := aggregate(ctx, m.views, pipeline, &results); err != nil {
+		return nil, err
+	}
+
+	scores := make([]trendingScore, len(results))
+
+	for i, result := range results {
+		scores[i] = trendingScore{BlogID: result.BlogID, Score: result.Score, Views: result.Views}
+	}
+
+	return scores, nil
+}
+
+func (m *mongoStore) BlogsWithStatus(ctx context.Context, status string, afterID primitive.ObjectID, limit int) ([]*blogItem, error) {
+	filter := bson.D{{Key: "status", Value: status}}
+
+	if !afterID.IsZero() {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$gt", Value: afterID}}})
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cur, err := m.collection.Find(ctx, filter, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blogs := []*blogItem{}
+
+	if err := cur.All(ctx, &blogs); err != nil {
+		return nil, err
+	}
+
+	return blogs, nil
+}
+
+func (m *mongoStore) SetBlogStatus(ctx context.Context, id primitive.ObjectID, from string, revision int64, to string, reasons []string) error {
+	filter := append(idFilter(id), bson.E{Key: "status", Value: from})
+
+	if from == "" || from == blogStatusPublished {
+		filter = append(idFilter(id), publishedFilter())
+	}
+
+	filter = append(filter, revisionFilter(revision))
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: to},
+		{Key: "moderation_reasons", Value: reasons},
+	}}}
+
+	res, err := m.collection.UpdateOne(ctx, filter, update)
+
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount > 0 {
+		return nil
+	}
+
+	// Tell a missing blog apart from one that changed.
+	if _, err := m.ReadBlog(ctx, id); err != nil {
+		return err
+	}
+
+	return errBlogStatusChanged
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// blogTranslation is a localized variant of the title and content of a blog.
+type blogTranslation struct {
+	Title     string    `bson:"title"`
+	Content   string    `bson:"content"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// parseLanguage validates a BCP 47 tag and returns it in canonical form, so
+// that "PT-br" and "pt-BR" refer to the same translation.
+func parseLanguage(tag string) (string, error) {
+	parsed, err := language.Parse(tag)
+
+	if err != nil {
+		return "", err
+	}
+
+	if parsed == language.Und {
+		return "", fmt.Errorf("language is undetermined")
+	}
+
+	return parsed.String(), nil
+}
+
+// availableLanguages returns the original language of a blog, "und" if it is
+// unknown, followed by its translations in sorted order.
+func availableLanguages(data *blogItem) []string {
+	original := data.Language
+
+	if original == "" {
+		original = language.Und.String()
+	}
+
+	translations := make([]string, 0, len(data.Translations))
+
+	for tag := range data.Translations {
+		translations = append(translations, tag)
+	}
+
+	sort.Strings(translations)
+
+	return append([]string{original}, translations...)
+}
+
+// localePreferences returns the languages a caller prefers, most preferred
+// first. An explicit locale must be valid; an invalid accept-language header is
+// ignored like a missing one.
+func localePreferences(ctx context.Context, locale string) ([]language.Tag, error) {
+	if locale != "" {
+		tags, _, err := language.ParseAcceptLanguage(locale)
+
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Invalid locale: %v", locale),
+			)
+		}
+
+		return tags, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok {
+		return nil, nil
+	}
+
+	values := md.Get("accept-language")
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(strings.Join(values, ","))
+
+	if err != nil {
+		return nil, nil
+	}
+
+	return tags, nil
+}
+
+// localize converts a blog to its API representation in the variant that best
+// matches the preferred languages. The matcher falls back from regional to
+// base languages and between related scripts; the original is used when no
+// translation matches.
+func localize(data *blogItem, prefs []language.Tag) *blogpb.Blog {
+	blog := blogItemToPb(data)
+
+	if len(prefs) == 0 || len(data.Translations) == 0 {
+		return blog
+	}
+
+	tags := availableLanguages(data)
+	supported := make([]language.Tag, len(tags))
+
+	for i, tag := range tags {
+		// Stored tags are canonical, Make cannot fail on them.
+		supported[i] = language.Make(tag)
+	}
+
+	_, index, confidence := language.NewMatcher(supported).Match(prefs...)
+
+	if confidence == language.No || index == 0 {
+		return blog
+	}
+
+	translation := data.Translations[tags[index]]
+
+	blog.Language = tags[index]
+	blog.Title = translation.Title
+	blog.Content = translation.Content
+
+	return blog
+}
+
+func (s *server) AddTranslation(ctx context.Context, req *blogpb.AddTranslationRequest) (*blogpb.AddTranslationResponse, error) {
+
+	fmt.Printf("Add translation request: %v\n", req)
+
+	data, err := s.changeTranslation(ctx, req.GetBlogId(), req.GetTranslation().GetLanguage(), func(data *blogItem, tag string) error {
+		if _, ok := data.Translations[tag]; ok {
+			return status.Errorf(
+				codes.AlreadyExists,
+				fmt.Sprintf("Blog already has a %v translation", tag),
+			)
+		}
+
+		if tag == data.Language {
+			return status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("%v is the original language of the blog", tag),
+			)
+		}
+
+		if data.Translations == nil {
+			data.Translations = make(map[string]blogTranslation)
+		}
+
+		data.Translations[tag] = blogTranslation{
+			Title:     req.GetTranslation().GetTitle(),
+			Content:   req.GetTranslation().GetContent(),
+			UpdatedAt: time.Now().UTC(),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.AddTranslationResponse{
+		Blog: blogItemToPb(data),
+	}
+
+	return resp, nil
+}
+
+func (s *server) UpdateTranslation(ctx context.Context, req *blogpb.UpdateTranslationRequest) (*blogpb.UpdateTranslationResponse, error) {
+
+	fmt.Printf("Update translation request: %v\n", req)
+
+	data, err := s.changeTranslation(ctx, req.GetBlogId(), req.GetTranslation().GetLanguage(), func(data *blogItem, tag string) error {
+		if _, ok := data.Translations[tag]; !ok {
+			return status.Errorf(
+				codes.NotFound,
+				fmt.Sprintf("Blog has no %v translation", tag),
+			)
+		}
+
+		data.Translations[tag] = blogTranslation{
+			Title:     req.GetTranslation().GetTitle(),
+			Content:   req.GetTranslation().GetContent(),
+			UpdatedAt: time.Now().UTC(),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.UpdateTranslationResponse{
+		Blog: blogItemToPb(data),
+	}
+
+	return resp, nil
+}
+
+func (s *server) RemoveTranslation(ctx context.Context, req *blogpb.RemoveTranslationRequest) (*blogpb.RemoveTranslationResponse, error) {
+
+	fmt.Printf("Remove translation request: %v\n", req)
+
+	data, err := s.changeTranslation(ctx, req.GetBlogId(), req.GetLanguage(), func(data *blogItem, tag string) error {
+		if _, ok := data.Translations[tag]; !ok {
+			return status.Errorf(
+				codes.NotFound,
+				fmt.Sprintf("Blog has no %v translation", tag),
+			)
+		}
+
+		delete(data.Translations, tag)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blogpb.RemoveTranslationResponse{
+		Blog: blogItemToPb(data),
+	}
+
+	return resp, nil
+}
+
+// changeTranslation reads a blog of the caller, applies change to the
+// translation tag and saves it, moderating the blog like UpdateBlog does so
+// translations go through moderation as well.
+func (s *server) changeTranslation(ctx context.Context, blogID string, tag string, change func(data *blogItem, tag string) error) (*blogItem, error) {
+	oid, err := primitive.ObjectIDFromHex(blogID)
+
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot parse ID",
+		)
+	}
+
+	tag, err = parseLanguage(tag)
+
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Invalid language: %v", err),
+		)
+	}
+
+	userID, err := s.auth.UserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.store.ReadBlog(ctx, oid)
+
+	if err == errBlogNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	if data.AuthorID != userID {
+		return nil, status.Errorf(
+			codes.PermissionDenied,
+			fmt.Sprintf("Blog %s is not by user %v", blogID, userID),
+		)
+	}
+
+	wasPublished := data.published()
+
+	if err := change(data, tag); err != nil {
+		return nil, err
+	}
+
+	data.SchemaVersion = currentBlogSchemaVersion
+	data.UpdatedAt = time.Now().UTC()
+
+	s.moderate(data)
+
+	eventID, err := s.prepareWrite(data, wasPublished)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	var translation *blogTranslation
+
+	if changed, ok := data.Translations[tag]; ok {
+		translation = &changed
+	}
+
+	err = s.store.SetTranslation(ctx, data, tag, translation)
+
+	if err != nil {
+		s.abandonEvent(eventID)
+	}
+
+	if err == errBlogNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %s", blogID),
+		)
+	}
+
+	if err == errBlogStatusChanged {
+		return nil, status.Errorf(
+			codes.Aborted,
+			"Blog was changed concurrently, try again",
+		)
+	}
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	s.written(data, wasPublished, eventID)
+
+	return data, nil
+}
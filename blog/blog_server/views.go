@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// viewDedupWindow is how long repeated reads by the same viewer count as
+	// a single view.
+	viewDedupWindow = 30 * time.Minute
+	// viewBucket is the granularity views are stored at.
+	viewBucket        = time.Hour
+	viewFlushInterval = 10 * time.Second
+	// viewFlushSize triggers an early flush when this many distinct
+	// blog/bucket pairs are pending.
+	viewFlushSize = 1000
+
+	defaultTrendingLimit = 10
+	maxTrendingLimit     = 100
+)
+
+var trendingWindows = map[blogpb.TrendingWindow]time.Duration{
+	blogpb.TrendingWindow_TRENDING_WINDOW_DAY:   24 * time.Hour,
+	blogpb.TrendingWindow_TRENDING_WINDOW_WEEK:  7 * 24 * time.Hour,
+	blogpb.TrendingWindow_TRENDING_WINDOW_MONTH: 30 * 24 * time.Hour,
+}
+
+// viewCount is the number of views of a blog within one viewBucket.
+type viewCount struct {
+	BlogID primitive.ObjectID
+	Bucket time.Time
+	Count  int64
+}
+
+// trendingScore is the decayed view score of a blog.
+type trendingScore struct {
+	BlogID primitive.ObjectID
+	Score  float64
+	Views  int64
+}
+
+// decayedScore weights views by age so they lose half their value every
+// halfLife.
+func decayedScore(count int64, age time.Duration, halfLife time.Duration) float64 {
+	return float64(count) * math.Exp(-math.Ln2*age.Seconds()/halfLife.Seconds())
+}
+
+type viewKey struct {
+	blogID primitive.ObjectID
+	viewer string
+}
+
+type bucketKey struct {
+	blogID primitive.ObjectID
+	bucket time.Time
+}
+
+// viewCounter deduplicates views per viewer and buffers the counts in memory
+// until they are flushed to the store in one batch.
+type viewCounter struct {
+	store blogStore
+
+	mu      sync.Mutex
+	seen    map[viewKey]time.Time
+	pending map[bucketKey]int64
+	flush   chan struct{}
+}
+
+func newViewCounter(store blogStore) *viewCounter {
+	return &viewCounter{
+		store:   store,
+		seen:    make(map[viewKey]time.Time),
+		pending: make(map[bucketKey]int64),
+		flush:   make(chan struct{}, 1),
+	}
+}
+
+// viewer identifies the caller for view deduplication by the authenticated
+// user, falling back to the client's IP address. Nothing the client chooses
+// freely is used, as a new value per read would count every read.
+func (s *server) viewer(ctx context.Context) string {
+	if userID, err := s.auth.UserID(ctx); err == nil {
+		return "user:" + userID
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+
+		if err != nil {
+			host = p.Addr.String()
+		}
+
+		return "ip:" + host
+	}
+
+	return ""
+}
+
+// Record counts a view of a blog unless the viewer already viewed it within
+// viewDedupWindow.
+func (v *viewCounter) Record(blogID primitive.ObjectID, viewer string) {
+	now := time.Now().UTC()
+	key := viewKey{blogID: blogID, viewer: viewer}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if last, ok := v.seen[key]; ok && now.Sub(last) < viewDedupWindow {
+		return
+	}
+
+	v.seen[key] = now
+	v.pending[bucketKey{blogID: blogID, bucket: now.Truncate(viewBucket)}]++
+
+	if len(v.pending) >= viewFlushSize {
+		select {
+		case v.flush <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run flushes pending views periodically until ctx is cancelled, then flushes
+// one last time.
+func (v *viewCounter) Run(ctx context.Context) {
+	ticker := time.NewTicker(viewFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			v.Flush(context.Background())
+			return
+		case <-ticker.C:
+		case <-v.flush:
+		}
+
+		v.Flush(ctx)
+	}
+}
+
+// Flush writes pending view counts to the store. Counts that fail to be
+// written are kept for the next flush.
+func (v *viewCounter) Flush(ctx context.Context) {
+	v.mu.Lock()
+
+	pending := v.pending
+	v.pending = make(map[bucketKey]int64)
+
+	// Forget viewers whose dedup window has passed.
+	cutoff := time.Now().UTC().Add(-viewDedupWindow)
+
+	for key, last := range v.seen {
+		if last.Before(cutoff) {
+			delete(v.seen, key)
+		}
+	}
+
+	v.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	counts := make([]viewCount, 0, len(pending))
+
+	for key, count := range pending {
+		counts = append(counts, viewCount{BlogID: key.blogID, Bucket: key.bucket, Count: count})
+	}
+
+	if err := v.store.RecordViews(ctx, counts); err != nil {
+		fmt.Printf("Failed to flush %d view counts: %v\n", len(counts), err)
+
+		v.mu.Lock()
+
+		for key, count := range pending {
+			v.pending[key] += count
+		}
+
+		v.mu.Unlock()
+	}
+}
+
+// aggregateTrending ranks view counts in process, for backends that cannot
+// aggregate natively.
+func aggregateTrending(counts []viewCount, now time.Time, since time.Time, halfLife time.Duration, limit int) []trendingScore {
+	scores := make(map[primitive.ObjectID]*trendingScore)
+
+	for _, c := range counts {
+		if c.Bucket.Before(since) {
+			continue
+		}
+
+		score, ok := scores[c.BlogID]
+
+		if !ok {
+			score = &trendingScore{BlogID: c.BlogID}
+			scores[c.BlogID] = score
+		}
+
+		score.Score += decayedScore(c.Count, now.Sub(c.Bucket), halfLife)
+		score.Views += c.Count
+	}
+
+	results := make([]trendingScore, 0, len(scores))
+
+	for _, score := range scores {
+		results = append(results, *score)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+
+		return results[i].BlogID.Hex() < results[j].BlogID.Hex()
+	})
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results
+}
+
+func (s *server) ListTrendingBlogs(ctx context.Context, req *blogpb.ListTrendingBlogsRequest) (*blogpb.ListTrendingBlogsResponse, error) {
+
+	fmt.Printf("List trending blogs request: %v\n", req)
+
+	window := req.GetWindow()
+
+	if window == blogpb.TrendingWindow_TRENDING_WINDOW_UNSPECIFIED {
+		window = blogpb.TrendingWindow_TRENDING_WINDOW_DAY
+	}
+
+	length, ok := trendingWindows[window]
+
+	if !ok {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Unknown window: %v", window),
+		)
+	}
+
+	limit := int(req.GetLimit())
+
+	if limit < 0 || limit > maxTrendingLimit {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Limit must be between 0 and %d", maxTrendingLimit),
+		)
+	}
+
+	if limit == 0 {
+		limit = defaultTrendingLimit
+	}
+
+	now := time.Now().UTC()
+
+	scores, err := s.store.TrendingBlogs(ctx, now, now.Add(-length), length/4, limit)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &blogpb.ListTrendingBlogsResponse{}
+
+	for _, score := range scores {
+		data, err := s.store.ReadBlog(ctx, score.BlogID)
+
+		if err == errBlogNotFound {
+			continue
+		}
+
+		if err != nil {
+			return nil, storeError(err)
+		}
+
+		if !data.published() {
+			continue
+		}
+
+		resp.Blogs = append(resp.Blogs, &blogpb.TrendingBlog{
+			Blog:  blogItemToPb(data),
+			Score: score.Score,
+			Views: score.Views,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,537 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// errWebhookNotFound is returned by a webhookStore when no webhook matches the
+// given ID.
+var errWebhookNotFound = errors.New("webhook not found")
+
+type webhookItem struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	URL        string             `bson:"url"`
+	Secret     string             `bson:"secret"`
+	EventTypes []string           `bson:"event_types"`
+	CreatedAt  time.Time          `bson:"created_at"`
+}
+
+// outboxItem is a pending delivery of one event to one webhook. Items stay in
+// the outbox until they are delivered or run out of attempts.
+type outboxItem struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	WebhookID     primitive.ObjectID `bson:"webhook_id"`
+	EventID       string             `bson:"event_id"`
+	EventType     string             `bson:"event_type"`
+	Payload       []byte             `bson:"payload"`
+	Attempts      int                `bson:"attempts"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	// Unconfirmed is set until the blog write the event reports, to the blog
+	// BlogID, is known to have been made.
+	Unconfirmed bool               `bson:"unconfirmed,omitempty"`
+	BlogID      primitive.ObjectID `bson:"blog_id,omitempty"`
+}
+
+type deliveryAttemptItem struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	WebhookID   primitive.ObjectID `bson:"webhook_id"`
+	EventID     string             `bson:"event_id"`
+	EventType   string             `bson:"event_type"`
+	Attempt     int                `bson:"attempt"`
+	StatusCode  int                `bson:"status_code"`
+	Error       string             `bson:"error,omitempty"`
+	Success     bool               `bson:"success"`
+	AttemptedAt time.Time          `bson:"attempted_at"`
+	Duration    time.Duration      `bson:"duration"`
+}
+
+// webhookStore persists registered webhooks, the delivery outbox and the log
+// of delivery attempts.
+type webhookStore interface {
+	CreateWebhook(ctx context.Context, data *webhookItem) (primitive.ObjectID, error)
+	ListWebhooks(ctx context.Context) ([]*webhookItem, error)
+	ReadWebhook(ctx context.Context, id primitive.ObjectID) (*webhookItem, error)
+	DeleteWebhook(ctx context.Context, id primitive.ObjectID) error
+
+	EnqueueDeliveries(ctx context.Context, items []*outboxItem) error
+	// ConfirmDeliveries marks the items of an event confirmed and due at now.
+	ConfirmDeliveries(ctx context.Context, eventID string, now time.Time) error
+	// RemoveDeliveries removes the items of an event.
+	RemoveDeliveries(ctx context.Context, eventID string) error
+	// ClaimDeliveries returns up to limit outbox items due at now and pushes
+	// their next attempt back by lease, so an item whose delivery is
+	// interrupted is retried once the lease expires.
+	ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*outboxItem, error)
+	// RescheduleDelivery stores the attempt count, next attempt time and
+	// confirmation of an item that failed and will be retried.
+	RescheduleDelivery(ctx context.Context, item *outboxItem) error
+	RemoveDelivery(ctx context.Context, id primitive.ObjectID) error
+
+	// AddDeliveryAttempt logs an attempt. Attempts older than
+	// webhookAttemptRetention are dropped.
+	AddDeliveryAttempt(ctx context.Context, data *deliveryAttemptItem) error
+	// ListDeliveryAttempts returns the newest attempts first. Zero filter
+	// values match every attempt.
+	ListDeliveryAttempts(ctx context.Context, webhookID primitive.ObjectID, eventID string, limit int) ([]*deliveryAttemptItem, error)
+}
+
+// mongoWebhookStore is a webhookStore backed by MongoDB collections.
+type mongoWebhookStore struct {
+	webhooks *mongo.Collection
+	outbox   *mongo.Collection
+	attempts *mongo.Collection
+}
+
+func newMongoWebhookStore(db *mongo.Database) *mongoWebhookStore {
+	return &mongoWebhookStore{
+		webhooks: db.Collection("webhooks"),
+		outbox:   db.Collection("webhook_outbox"),
+		attempts: db.Collection("webhook_attempts"),
+	}
+}
+
+// EnsureIndexes creates the indexes the store relies on.
+func (m *mongoWebhookStore) EnsureIndexes(ctx context.Context) error {
+	// The dispatcher claims the deliveries that are due first.
+	_, err := m.outbox.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "next_attempt_at", Value: 1}}},
+		{Keys: bson.D{{Key: "webhook_id", Value: 1}}},
+		{Keys: bson.D{{Key: "event_id", Value: 1}}},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = m.attempts.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "webhook_id", Value: 1},
+				{Key: "_id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "event_id", Value: 1},
+				{Key: "_id", Value: -1},
+			},
+		},
+		// Attempts expire once they are older than the retention.
+		{
+			Keys:    bson.D{{Key: "attempted_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(webhookAttemptRetention / time.Second)),
+		},
+	})
+
+	return err
+}
+
+func (m *mongoWebhookStore) CreateWebhook(ctx context.Context, data *webhookItem) (primitive.ObjectID, error) {
+	res, err := m.webhooks.InsertOne(ctx, data)
+
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+
+	if !ok {
+		return primitive.NilObjectID, errors.New("cannot convert to OID")
+	}
+
+	return oid, nil
+}
+
+func (m *mongoWebhookStore) ListWebhooks(ctx context.Context) ([]*webhookItem, error) {
+	cur, err := m.webhooks.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := []*webhookItem{}
+
+	if err := cur.All(ctx, &webhooks); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (m *mongoWebhookStore) ReadWebhook(ctx context.Context, id primitive.ObjectID) (*webhookItem, error) {
+	data := &webhookItem{}
+
+	err := m.webhooks.FindOne(ctx, idFilter(id)).Decode(data)
+
+	if err == mongo.ErrNoDocuments {
+		return nil, errWebhookNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (m *mongoWebhookStore) DeleteWebhook(ctx context.Context, id primitive.ObjectID) error {
+	res, err := m.webhooks.DeleteOne(ctx, idFilter(id))
+
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return errWebhookNotFound
+	}
+
+	_, err = m.outbox.DeleteMany(ctx, bson.D{{Key: "webhook_id", Value: id}})
+
+	return err
+}
+
+func (m *mongoWebhookStore) EnqueueDeliveries(ctx context.Context, items []*outboxItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(items))
+
+	for i, item := range items {
+		docs[i] = item
+	}
+
+	_, err := m.outbox.InsertMany(ctx, docs)
+
+	return err
+}
+
+func (m *mongoWebhookStore) ConfirmDeliveries(ctx context.Context, eventID string, now time.Time) error {
+	update := bson.D{
+		{Key: "$set", Value: bson.D{{Key: "next_attempt_at", Value: now}}},
+		{Key: "$unset", Value: bson.D{{Key: "unconfirmed", Value: ""}}},
+	}
+
+	_, err := m.outbox.UpdateMany(ctx, bson.D{{Key: "event_id", Value: eventID}}, update)
+
+	return err
+}
+
+func (m *mongoWebhookStore) RemoveDeliveries(ctx context.Context, eventID string) error {
+	_, err := m.outbox.DeleteMany(ctx, bson.D{{Key: "event_id", Value: eventID}})
+
+	return err
+}
+
+func (m *mongoWebhookStore) ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*outboxItem, error) {
+	items := []*outboxItem{}
+
+	filter := bson.D{{Key: "next_attempt_at", Value: bson.D{{Key: "$lte", Value: now}}}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "next_attempt_at", Value: now.Add(lease)}}}}
+	opts := options.FindOneAndUpdate().SetSort(bson.D{{Key: "next_attempt_at", Value: 1}})
+
+	// Claim one item at a time so several servers can share the outbox
+	// without delivering the same item twice.
+	for len(items) < limit {
+		item := &outboxItem{}
+
+		err := m.outbox.FindOneAndUpdate(ctx, filter, update, opts).Decode(item)
+
+		if err == mongo.ErrNoDocuments {
+			break
+		}
+
+		if err != nil {
+			return items, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (m *mongoWebhookStore) RescheduleDelivery(ctx context.Context, item *outboxItem) error {
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "attempts", Value: item.Attempts},
+		{Key: "next_attempt_at", Value: item.NextAttemptAt},
+		{Key: "unconfirmed", Value: item.Unconfirmed},
+	}}}
+
+	_, err := m.outbox.UpdateOne(ctx, idFilter(item.ID), update)
+
+	return err
+}
+
+func (m *mongoWebhookStore) RemoveDelivery(ctx context.Context, id primitive.ObjectID) error {
+	_, err := m.outbox.DeleteOne(ctx, idFilter(id))
+
+	return err
+}
+
+func (m *mongoWebhookStore) AddDeliveryAttempt(ctx context.Context, data *deliveryAttemptItem) error {
+	_, err := m.attempts.InsertOne(ctx, data)
+
+	return err
+}
+
+func (m *mongoWebhookStore) ListDeliveryAttempts(ctx context.Context, webhookID primitive.ObjectID, eventID string, limit int) ([]*deliveryAttemptItem, error) {
+	filter := bson.D{}
+
+	if !webhookID.IsZero() {
+		filter = append(filter, bson.E{Key: "webhook_id", Value: webhookID})
+	}
+
+	if eventID != "" {
+		filter = append(filter, bson.E{Key: "event_id", Value: eventID})
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cur, err := m.attempts.Find(ctx, filter, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := []*deliveryAttemptItem{}
+
+	if err := cur.All(ctx, &attempts); err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+// memoryWebhookStore is a webhookStore kept in process memory. Pending
+// deliveries do not survive a restart.
+type memoryWebhookStore struct {
+	mu       sync.Mutex
+	webhooks map[primitive.ObjectID]*webhookItem
+	outbox   map[primitive.ObjectID]*outboxItem
+	attempts []*deliveryAttemptItem
+}
+
+func newMemoryWebhookStore() *memoryWebhookStore {
+	return &memoryWebhookStore{
+		webhooks: make(map[primitive.ObjectID]*webhookItem),
+		outbox:   make(map[primitive.ObjectID]*outboxItem),
+	}
+}
+
+func (m *memoryWebhookStore) CreateWebhook(ctx context.Context, data *webhookItem) (primitive.ObjectID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item := *data
+	item.ID = primitive.NewObjectID()
+	item.EventTypes = append([]string(nil), data.EventTypes...)
+
+	m.webhooks[item.ID] = &item
+
+	return item.ID, nil
+}
+
+func (m *memoryWebhookStore) ListWebhooks(ctx context.Context) ([]*webhookItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	webhooks := []*webhookItem{}
+
+	for _, data := range m.webhooks {
+		item := *data
+		webhooks = append(webhooks, &item)
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		return bytes.Compare(webhooks[i].ID[:], webhooks[j].ID[:]) < 0
+	})
+
+	return webhooks, nil
+}
+
+func (m *memoryWebhookStore) ReadWebhook(ctx context.Context, id primitive.ObjectID) (*webhookItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.webhooks[id]
+
+	if !ok {
+		return nil, errWebhookNotFound
+	}
+
+	item := *data
+
+	return &item, nil
+}
+
+func (m *memoryWebhookStore) DeleteWebhook(ctx context.Context, id primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.webhooks[id]; !ok {
+		return errWebhookNotFound
+	}
+
+	delete(m.webhooks, id)
+
+	for itemID, item := range m.outbox {
+		if item.WebhookID == id {
+			delete(m.outbox, itemID)
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryWebhookStore) EnqueueDeliveries(ctx context.Context, items []*outboxItem) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, data := range items {
+		item := *data
+		item.ID = primitive.NewObjectID()
+
+		m.outbox[item.ID] = &item
+	}
+
+	return nil
+}
+
+func (m *memoryWebhookStore) ConfirmDeliveries(ctx context.Context, eventID string, now time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, item := range m.outbox {
+		if item.EventID == eventID {
+			item.Unconfirmed = false
+			item.NextAttemptAt = now
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryWebhookStore) RemoveDeliveries(ctx context.Context, eventID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, item := range m.outbox {
+		if item.EventID == eventID {
+			delete(m.outbox, id)
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryWebhookStore) ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*outboxItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	due := []*outboxItem{}
+
+	for _, item := range m.outbox {
+		if !item.NextAttemptAt.After(now) {
+			due = append(due, item)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+	})
+
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	items := make([]*outboxItem, len(due))
+
+	for i, item := range due {
+		claimed := *item
+		items[i] = &claimed
+
+		item.NextAttemptAt = now.Add(lease)
+	}
+
+	return items, nil
+}
+
+func (m *memoryWebhookStore) RescheduleDelivery(ctx context.Context, data *outboxItem) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if item, ok := m.outbox[data.ID]; ok {
+		item.Attempts = data.Attempts
+		item.NextAttemptAt = data.NextAttemptAt
+		item.Unconfirmed = data.Unconfirmed
+	}
+
+	return nil
+}
+
+func (m *memoryWebhookStore) RemoveDelivery(ctx context.Context, id primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.outbox, id)
+
+	return nil
+}
+
+func (m *memoryWebhookStore) AddDeliveryAttempt(ctx context.Context, data *deliveryAttemptItem) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item := *data
+	item.ID = primitive.NewObjectID()
+
+	// Attempts are appended in order, so the expired ones come first.
+	expired := 0
+	cutoff := item.AttemptedAt.Add(-webhookAttemptRetention)
+
+	for expired < len(m.attempts) && m.attempts[expired].AttemptedAt.Before(cutoff) {
+		expired++
+	}
+
+	m.attempts = append(m.attempts[expired:], &item)
+
+	return nil
+}
+
+func (m *memoryWebhookStore) ListDeliveryAttempts(ctx context.Context, webhookID primitive.ObjectID, eventID string, limit int) ([]*deliveryAttemptItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	attempts := []*deliveryAttemptItem{}
+
+	for i := len(m.attempts) - 1; i >= 0 && len(attempts) < limit; i-- {
+		data := m.attempts[i]
+
+		if !webhookID.IsZero() && data.WebhookID != webhookID {
+			continue
+		}
+
+		if eventID != "" && data.EventID != eventID {
+			continue
+		}
+
+		item := *data
+		attempts = append(attempts, &item)
+	}
+
+	return attempts, nil
+}
@@ -0,0 +1,717 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/blog/blogpb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	blogCreatedEvent = "blog.created"
+	blogUpdatedEvent = "blog.updated"
+	blogDeletedEvent = "blog.deleted"
+)
+
+var eventTypeNames = map[blogpb.BlogEventType]string{
+	blogpb.BlogEventType_BLOG_EVENT_TYPE_CREATED: blogCreatedEvent,
+	blogpb.BlogEventType_BLOG_EVENT_TYPE_UPDATED: blogUpdatedEvent,
+	blogpb.BlogEventType_BLOG_EVENT_TYPE_DELETED: blogDeletedEvent,
+}
+
+func eventTypeFromName(name string) blogpb.BlogEventType {
+	for eventType, eventName := range eventTypeNames {
+		if eventName == name {
+			return eventType
+		}
+	}
+
+	return blogpb.BlogEventType_BLOG_EVENT_TYPE_UNSPECIFIED
+}
+
+const (
+	webhookPollInterval = time.Second
+	webhookBatchSize    = 20
+	webhookTimeout      = 10 * time.Second
+	// webhookLease must be longer than a single delivery can take.
+	webhookLease       = 2 * webhookTimeout
+	webhookMaxAttempts = 10
+	webhookBaseBackoff = 5 * time.Second
+	webhookMaxBackoff  = time.Hour
+	// webhookHold is how long the deliveries of an event wait for the blog
+	// write it reports to be confirmed. It must be longer than a write can
+	// take.
+	webhookHold = 2 * time.Minute
+	// webhookAttemptRetention is how long delivery attempts are kept for
+	// ListDeliveryAttempts.
+	webhookAttemptRetention = 30 * 24 * time.Hour
+	// webhookSubscriptionTTL is how long Prepare uses the cached webhook list
+	// before reading it again, which bounds how late webhooks registered on
+	// other servers start getting events.
+	webhookSubscriptionTTL = time.Minute
+)
+
+// blogEvent is the JSON body POSTed to webhook endpoints.
+type blogEvent struct {
+	ID        string         `json:"id"`
+	Type      string         `json:"type"`
+	CreatedAt time.Time      `json:"created_at"`
+	Blog      blogEventEntry `json:"blog"`
+}
+
+type blogEventEntry struct {
+	ID       string   `json:"id"`
+	AuthorID string   `json:"author_id,omitempty"`
+	Title    string   `json:"title,omitempty"`
+	Content  string   `json:"content,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+}
+
+func newBlogEventEntry(data *blogItem) blogEventEntry {
+	return blogEventEntry{
+		ID:       data.ID.Hex(),
+		AuthorID: data.AuthorID,
+		Title:    data.Title,
+		Content:  data.Content,
+		Tags:     data.Tags,
+	}
+}
+
+// webhookDispatcher turns blog events into outbox entries and delivers them
+// to the registered endpoints in the background.
+//
+// Entries are written before the blog write they report, so that no event
+// is lost if the server stops right after the write, and held until the
+// write is confirmed. Entries never confirmed are only delivered if blogs
+// shows the write was made and has not been superseded since.
+//
+// Every delivery is a POST of a blogEvent with these headers:
+//
+//	X-Blog-Event:     the event type, e.g. blog.created
+//	X-Blog-Delivery:  the event ID, identical across retries
+//	X-Blog-Timestamp: unix seconds at which the request was signed
+//	X-Blog-Signature: sha256=<hex HMAC-SHA256 of "<timestamp>.<body>">
+type webhookDispatcher struct {
+	store  webhookStore
+	blogs  blogStore
+	client *http.Client
+
+	mu sync.Mutex
+	// webhooks is the cached webhook list, nil until it is first read. It
+	// is read again once loadedAt is older than webhookSubscriptionTTL, or
+	// zero after Invalidate. generation counts the invalidations so that a
+	// list read before one is not taken as fresh.
+	webhooks   []*webhookItem
+	loadedAt   time.Time
+	generation int
+
+	wg sync.WaitGroup
+}
+
+func newWebhookDispatcher(store webhookStore, blogs blogStore) *webhookDispatcher {
+	return &webhookDispatcher{
+		store: store,
+		blogs: blogs,
+		client: &http.Client{
+			Timeout: webhookTimeout,
+		},
+	}
+}
+
+// Prepare queues an event for every webhook subscribed to its type, held until
+// Confirm. It must be called before the blog write the event reports. data
+// only needs an ID for deleted blogs. It returns the event ID, or "" if no
+// webhook subscribes.
+//
+// Blog writes only fail with the webhook store when it cannot queue the
+// deliveries of subscribed webhooks, or when the webhook list has never
+// been read. Failing to refresh the cached list is logged and the stale list
+// used instead.
+func (d *webhookDispatcher) Prepare(ctx context.Context, eventType string, data *blogItem) (string, error) {
+	webhooks, err := d.subscriptions(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+
+	event := blogEvent{
+		ID:        primitive.NewObjectID().Hex(),
+		Type:      eventType,
+		CreatedAt: now,
+		Blog:      newBlogEventEntry(data),
+	}
+
+	payload, err := json.Marshal(event)
+
+	if err != nil {
+		return "", err
+	}
+
+	items := []*outboxItem{}
+
+	for _, webhook := range webhooks {
+		if !webhook.subscribes(eventType) {
+			continue
+		}
+
+		items = append(items, &outboxItem{
+			WebhookID:     webhook.ID,
+			EventID:       event.ID,
+			EventType:     eventType,
+			Payload:       payload,
+			NextAttemptAt: now.Add(webhookHold),
+			CreatedAt:     now,
+			Unconfirmed:   true,
+			BlogID:        data.ID,
+		})
+	}
+
+	if len(items) == 0 {
+		return "", nil
+	}
+
+	if err := d.store.EnqueueDeliveries(ctx, items); err != nil {
+		return "", err
+	}
+
+	return event.ID, nil
+}
+
+// subscriptions returns the cached webhook list, reading it again once it is
+// stale.
+func (d *webhookDispatcher) subscriptions(ctx context.Context) ([]*webhookItem, error) {
+	d.mu.Lock()
+	webhooks, loadedAt, generation := d.webhooks, d.loadedAt, d.generation
+	d.mu.Unlock()
+
+	if webhooks != nil && time.Since(loadedAt) < webhookSubscriptionTTL {
+		return webhooks, nil
+	}
+
+	fresh, err := d.store.ListWebhooks(ctx)
+
+	if err != nil {
+		if webhooks == nil {
+			return nil, err
+		}
+
+		fmt.Printf("Failed to refresh webhooks, using the cached list: %v\n", err)
+
+		return webhooks, nil
+	}
+
+	if fresh == nil {
+		fresh = []*webhookItem{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.webhooks = fresh
+
+	if d.generation == generation {
+		d.loadedAt = time.Now()
+	}
+
+	return fresh, nil
+}
+
+// Invalidate makes the next Prepare read the webhook list again. It is called
+// after webhooks are registered or deleted.
+func (d *webhookDispatcher) Invalidate() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.loadedAt = time.Time{}
+	d.generation++
+}
+
+// Confirm releases the deliveries of an event once its blog write is made.
+func (d *webhookDispatcher) Confirm(ctx context.Context, eventID string) error {
+	if eventID == "" {
+		return nil
+	}
+
+	return d.store.ConfirmDeliveries(ctx, eventID, time.Now().UTC())
+}
+
+// Abandon removes the deliveries of an event whose blog write failed.
+func (d *webhookDispatcher) Abandon(ctx context.Context, eventID string) error {
+	if eventID == "" {
+		return nil
+	}
+
+	return d.store.RemoveDeliveries(ctx, eventID)
+}
+
+// happened reports whether the blog write of an unconfirmed event was made
+// and the blog still holds what the event reports. An event superseded by a
+// later write is not delivered, the later write has its own.
+func (d *webhookDispatcher) happened(ctx context.Context, item *outboxItem) (bool, error) {
+	data, err := d.blogs.ReadBlog(ctx, item.BlogID)
+
+	if err == errBlogNotFound {
+		return item.EventType == blogDeletedEvent, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if item.EventType == blogDeletedEvent {
+		return !data.published(), nil
+	}
+
+	if !data.published() {
+		return false, nil
+	}
+
+	event := blogEvent{}
+
+	if err := json.Unmarshal(item.Payload, &event); err != nil {
+		return false, err
+	}
+
+	reported, err := json.Marshal(event.Blog)
+
+	if err != nil {
+		return false, err
+	}
+
+	current, err := json.Marshal(newBlogEventEntry(data))
+
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(reported, current), nil
+}
+
+func (w *webhookItem) subscribes(eventType string) bool {
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Run delivers due outbox entries until ctx is cancelled.
+func (d *webhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.wg.Wait()
+			return
+		case <-ticker.C:
+		}
+
+		// Keep claiming while full batches come back so a backlog drains
+		// faster than one batch per poll.
+		for ctx.Err() == nil {
+			items, err := d.store.ClaimDeliveries(ctx, time.Now().UTC(), webhookLease, webhookBatchSize)
+
+			if err != nil && ctx.Err() == nil {
+				fmt.Printf("Error while claiming webhook deliveries: %v\n", err)
+			}
+
+			for _, item := range items {
+				d.wg.Add(1)
+
+				go func(item *outboxItem) {
+					defer d.wg.Done()
+					d.deliver(item)
+				}(item)
+			}
+
+			// Wait for the batch so a slow endpoint cannot pile up goroutines.
+			d.wg.Wait()
+
+			if len(items) < webhookBatchSize {
+				break
+			}
+		}
+	}
+}
+
+func (d *webhookDispatcher) deliver(item *outboxItem) {
+	// Deliveries and their bookkeeping are not tied to the dispatcher
+	// context so an in-flight attempt is recorded during shutdown.
+	ctx, cancel := context.WithTimeout(context.Background(), webhookLease)
+	defer cancel()
+
+	webhook, err := d.store.ReadWebhook(ctx, item.WebhookID)
+
+	if err == errWebhookNotFound {
+		d.store.RemoveDelivery(ctx, item.ID)
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error while reading webhook %v: %v\n", item.WebhookID.Hex(), err)
+		return
+	}
+
+	if item.Unconfirmed {
+		happened, err := d.happened(ctx, item)
+
+		if err != nil {
+			fmt.Printf("Error while checking the write of event %v: %v\n", item.EventID, err)
+			return
+		}
+
+		if !happened {
+			fmt.Printf("Dropping event %v, its blog write was not made or has been superseded\n", item.EventID)
+			d.store.RemoveDelivery(ctx, item.ID)
+			return
+		}
+
+		item.Unconfirmed = false
+	}
+
+	item.Attempts++
+
+	attempt := &deliveryAttemptItem{
+		WebhookID:   item.WebhookID,
+		EventID:     item.EventID,
+		EventType:   item.EventType,
+		Attempt:     item.Attempts,
+		AttemptedAt: time.Now().UTC(),
+	}
+
+	attempt.StatusCode, err = d.post(ctx, webhook, item)
+	attempt.Duration = time.Since(attempt.AttemptedAt)
+	attempt.Success = err == nil
+
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+
+	if err := d.store.AddDeliveryAttempt(ctx, attempt); err != nil {
+		fmt.Printf("Error while recording webhook delivery attempt: %v\n", err)
+	}
+
+	if attempt.Success || item.Attempts >= webhookMaxAttempts {
+		if !attempt.Success {
+			fmt.Printf("Giving up on event %v for webhook %v after %d attempts\n", item.EventID, webhook.ID.Hex(), item.Attempts)
+		}
+
+		if err := d.store.RemoveDelivery(ctx, item.ID); err != nil {
+			fmt.Printf("Error while removing webhook delivery: %v\n", err)
+		}
+
+		return
+	}
+
+	item.NextAttemptAt = time.Now().UTC().Add(webhookBackoff(item.Attempts))
+
+	if err := d.store.RescheduleDelivery(ctx, item); err != nil {
+		fmt.Printf("Error while rescheduling webhook delivery: %v\n", err)
+	}
+}
+
+// webhookBackoff returns the delay before the next attempt, doubling with each
+// failed attempt and jittered by up to 20% so endpoints that come back up are
+// not hit by every pending delivery at once.
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookMaxBackoff
+
+	if attempts < 20 {
+		if b := webhookBaseBackoff << (attempts - 1); b < webhookMaxBackoff {
+			backoff = b
+		}
+	}
+
+	return backoff - time.Duration(rand.Int63n(int64(backoff/5)+1))
+}
+
+func signWebhookPayload(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (d *webhookDispatcher) post(ctx context.Context, webhook *webhookItem, item *outboxItem) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(item.Payload))
+
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "grpc-go-course-blog-webhooks")
+	req.Header.Set("X-Blog-Event", item.EventType)
+	req.Header.Set("X-Blog-Delivery", item.EventID)
+	req.Header.Set("X-Blog-Timestamp", timestamp)
+	req.Header.Set("X-Blog-Signature", signWebhookPayload(webhook.Secret, timestamp, item.Payload))
+
+	res, err := d.client.Do(req)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("unexpected status: %v", res.Status)
+	}
+
+	return res.StatusCode, nil
+}
+
+// webhookServer implements WebhookService. Webhooks receive every blog and
+// their secrets, so every call must be made by one of operators.
+type webhookServer struct {
+	blogpb.WebhookServiceServer
+
+	store      webhookStore
+	dispatcher *webhookDispatcher
+	auth       *authenticator
+	operators  userSet
+}
+
+const (
+	defaultDeliveryAttemptsLimit = 50
+	maxDeliveryAttemptsLimit     = 500
+)
+
+func webhookItemToPb(data *webhookItem) *blogpb.Webhook {
+	webhook := &blogpb.Webhook{
+		Id:        data.ID.Hex(),
+		Url:       data.URL,
+		CreatedAt: timestamppb.New(data.CreatedAt),
+	}
+
+	for _, name := range data.EventTypes {
+		webhook.EventTypes = append(webhook.EventTypes, eventTypeFromName(name))
+	}
+
+	return webhook
+}
+
+func (s *webhookServer) RegisterWebhook(ctx context.Context, req *blogpb.RegisterWebhookRequest) (*blogpb.RegisterWebhookResponse, error) {
+
+	fmt.Printf("Register webhook request for %v\n", req.GetWebhook().GetUrl())
+
+	if _, err := s.auth.Authorize(ctx, s.operators); err != nil {
+		return nil, err
+	}
+
+	webhook := req.GetWebhook()
+
+	u, err := url.Parse(webhook.GetUrl())
+
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Invalid webhook URL: %v", webhook.GetUrl()),
+		)
+	}
+
+	if webhook.GetSecret() == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Webhook secret is required",
+		)
+	}
+
+	if len(webhook.GetEventTypes()) == 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"At least one event type is required",
+		)
+	}
+
+	data := &webhookItem{
+		URL:       u.String(),
+		Secret:    webhook.GetSecret(),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	seen := map[string]bool{}
+
+	for _, eventType := range webhook.GetEventTypes() {
+		name, ok := eventTypeNames[eventType]
+
+		if !ok {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Unknown event type: %v", eventType),
+			)
+		}
+
+		if !seen[name] {
+			seen[name] = true
+			data.EventTypes = append(data.EventTypes, name)
+		}
+	}
+
+	oid, err := s.store.CreateWebhook(ctx, data)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	s.dispatcher.Invalidate()
+
+	data.ID = oid
+
+	resp := &blogpb.RegisterWebhookResponse{
+		Webhook: webhookItemToPb(data),
+	}
+
+	return resp, nil
+}
+
+func (s *webhookServer) ListWebhooks(ctx context.Context, req *blogpb.ListWebhooksRequest) (*blogpb.ListWebhooksResponse, error) {
+
+	fmt.Printf("List webhooks request: %v\n", req)
+
+	if _, err := s.auth.Authorize(ctx, s.operators); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.store.ListWebhooks(ctx)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &blogpb.ListWebhooksResponse{}
+
+	for _, data := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, webhookItemToPb(data))
+	}
+
+	return resp, nil
+}
+
+func (s *webhookServer) DeleteWebhook(ctx context.Context, req *blogpb.DeleteWebhookRequest) (*blogpb.DeleteWebhookResponse, error) {
+
+	fmt.Printf("Delete webhook request: %v\n", req)
+
+	if _, err := s.auth.Authorize(ctx, s.operators); err != nil {
+		return nil, err
+	}
+
+	webhookID := req.GetWebhookId()
+
+	oid, err := primitive.ObjectIDFromHex(webhookID)
+
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot parse ID",
+		)
+	}
+
+	deleteErr := s.store.DeleteWebhook(ctx, oid)
+
+	if deleteErr == errWebhookNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find webhook with specified ID: %s", webhookID),
+		)
+	}
+
+	if deleteErr != nil {
+		return nil, storeError(deleteErr)
+	}
+
+	s.dispatcher.Invalidate()
+
+	resp := &blogpb.DeleteWebhookResponse{
+		WebhookId: webhookID,
+	}
+
+	return resp, nil
+}
+
+func (s *webhookServer) ListDeliveryAttempts(ctx context.Context, req *blogpb.ListDeliveryAttemptsRequest) (*blogpb.ListDeliveryAttemptsResponse, error) {
+
+	fmt.Printf("List delivery attempts request: %v\n", req)
+
+	if _, err := s.auth.Authorize(ctx, s.operators); err != nil {
+		return nil, err
+	}
+
+	webhookID := primitive.NilObjectID
+
+	if req.GetWebhookId() != "" {
+		oid, err := primitive.ObjectIDFromHex(req.GetWebhookId())
+
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"Cannot parse webhook ID",
+			)
+		}
+
+		webhookID = oid
+	}
+
+	limit := int(req.GetLimit())
+
+	if limit < 0 || limit > maxDeliveryAttemptsLimit {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Limit must be between 0 and %d", maxDeliveryAttemptsLimit),
+		)
+	}
+
+	if limit == 0 {
+		limit = defaultDeliveryAttemptsLimit
+	}
+
+	attempts, err := s.store.ListDeliveryAttempts(ctx, webhookID, req.GetEventId(), limit)
+
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &blogpb.ListDeliveryAttemptsResponse{}
+
+	for _, data := range attempts {
+		resp.Attempts = append(resp.Attempts, &blogpb.DeliveryAttempt{
+			Id:          data.ID.Hex(),
+			WebhookId:   data.WebhookID.Hex(),
+			EventId:     data.EventID,
+			EventType:   eventTypeFromName(data.EventType),
+			Attempt:     int32(data.Attempt),
+			StatusCode:  int32(data.StatusCode),
+			Error:       data.Error,
+			Success:     data.Success,
+			AttemptedAt: timestamppb.New(data.AttemptedAt),
+			DurationMs:  data.Duration.Milliseconds(),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// flakyWebhookStore counts ListWebhooks calls and fails them while down is
+// set.
+type flakyWebhookStore struct {
+	*memoryWebhookStore
+
+	down  bool
+	lists int
+}
+
+func (f *flakyWebhookStore) ListWebhooks(ctx context.Context) ([]*webhookItem, error) {
+	f.lists++
+
+	if f.down {
+		return nil, errors.New("webhook store down")
+	}
+
+	return f.memoryWebhookStore.ListWebhooks(ctx)
+}
+
+func TestWebhookDispatcherCachesSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	data := &blogItem{ID: primitive.NewObjectID()}
+
+	tests := []struct {
+		name string
+		// before runs between a first Prepare and the checked one.
+		before    func(d *webhookDispatcher, store *flakyWebhookStore)
+		firstDown bool
+		wantLists int
+		wantEvent bool
+		wantErr   bool
+	}{
+		{
+			name:      "fresh list is reused",
+			before:    func(d *webhookDispatcher, store *flakyWebhookStore) {},
+			wantLists: 1,
+			wantEvent: true,
+		},
+		{
+			name: "invalidated list is read again",
+			before: func(d *webhookDispatcher, store *flakyWebhookStore) {
+				store.CreateWebhook(ctx, &webhookItem{URL: "http://example.com/second", EventTypes: []string{blogCreatedEvent}})
+				d.Invalidate()
+			},
+			wantLists: 2,
+			wantEvent: true,
+		},
+		{
+			name: "expired list is read again",
+			before: func(d *webhookDispatcher, store *flakyWebhookStore) {
+				d.loadedAt = time.Now().Add(-webhookSubscriptionTTL)
+			},
+			wantLists: 2,
+			wantEvent: true,
+		},
+		{
+			name: "stale list is used when the store fails",
+			before: func(d *webhookDispatcher, store *flakyWebhookStore) {
+				store.down = true
+				d.Invalidate()
+			},
+			wantLists: 2,
+			wantEvent: true,
+		},
+		{
+			name:      "unread list fails",
+			before:    func(d *webhookDispatcher, store *flakyWebhookStore) {},
+			firstDown: true,
+			wantLists: 2,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &flakyWebhookStore{memoryWebhookStore: newMemoryWebhookStore(), down: tt.firstDown}
+			store.CreateWebhook(ctx, &webhookItem{URL: "http://example.com/first", EventTypes: []string{blogCreatedEvent}})
+
+			d := newWebhookDispatcher(store, newMemoryStore())
+			d.Prepare(ctx, blogCreatedEvent, data)
+
+			tt.before(d, store)
+
+			eventID, err := d.Prepare(ctx, blogCreatedEvent, data)
+
+			if (err != nil) != tt.wantErr || (eventID != "") != tt.wantEvent {
+				t.Errorf("got %q, %v, want an event %v and an error %v", eventID, err, tt.wantEvent, tt.wantErr)
+			}
+
+			if store.lists != tt.wantLists {
+				t.Errorf("got %d ListWebhooks calls, want %d", store.lists, tt.wantLists)
+			}
+		})
+	}
+}
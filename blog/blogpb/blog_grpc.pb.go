@@ -23,6 +23,18 @@ type BlogServiceClient interface {
 	UpdateBlog(ctx context.Context, in *UpdateBlogRequest, opts ...grpc.CallOption) (*UpdateBlogResponse, error)
 	DeleteBlog(ctx context.Context, in *DeleteBlogRequest, opts ...grpc.CallOption) (*DeleteBlogResponse, error)
 	ListBlog(ctx context.Context, in *ListBlogRequest, opts ...grpc.CallOption) (BlogService_ListBlogClient, error)
+	GetBlogStats(ctx context.Context, in *GetBlogStatsRequest, opts ...grpc.CallOption) (*GetBlogStatsResponse, error)
+	GetRelatedBlogs(ctx context.Context, in *GetRelatedBlogsRequest, opts ...grpc.CallOption) (*GetRelatedBlogsResponse, error)
+	ReactToBlog(ctx context.Context, in *ReactToBlogRequest, opts ...grpc.CallOption) (*ReactToBlogResponse, error)
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error)
+	// Sends the current counts, then every change. Bursts of changes are
+	// coalesced into a single message.
+	WatchReactions(ctx context.Context, in *WatchReactionsRequest, opts ...grpc.CallOption) (BlogService_WatchReactionsClient, error)
+	ListTrendingBlogs(ctx context.Context, in *ListTrendingBlogsRequest, opts ...grpc.CallOption) (*ListTrendingBlogsResponse, error)
+	// Fails with AlreadyExists if the blog has a translation to the language.
+	AddTranslation(ctx context.Context, in *AddTranslationRequest, opts ...grpc.CallOption) (*AddTranslationResponse, error)
+	UpdateTranslation(ctx context.Context, in *UpdateTranslationRequest, opts ...grpc.CallOption) (*UpdateTranslationResponse, error)
+	RemoveTranslation(ctx context.Context, in *RemoveTranslationRequest, opts ...grpc.CallOption) (*RemoveTranslationResponse, error)
 }
 
 type blogServiceClient struct {
@@ -101,6 +113,110 @@ func (x *blogServiceListBlogClient) Recv() (*ListBlogResponse, error) {
 	return m, nil
 }
 
+func (c *blogServiceClient) GetBlogStats(ctx context.Context, in *GetBlogStatsRequest, opts ...grpc.CallOption) (*GetBlogStatsResponse, error) {
+	out := new(GetBlogStatsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/GetBlogStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetRelatedBlogs(ctx context.Context, in *GetRelatedBlogsRequest, opts ...grpc.CallOption) (*GetRelatedBlogsResponse, error) {
+	out := new(GetRelatedBlogsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/GetRelatedBlogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ReactToBlog(ctx context.Context, in *ReactToBlogRequest, opts ...grpc.CallOption) (*ReactToBlogResponse, error) {
+	out := new(ReactToBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ReactToBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error) {
+	out := new(RemoveReactionResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/RemoveReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) WatchReactions(ctx context.Context, in *WatchReactionsRequest, opts ...grpc.CallOption) (BlogService_WatchReactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlogService_ServiceDesc.Streams[1], "/blog.BlogService/WatchReactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceWatchReactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_WatchReactionsClient interface {
+	Recv() (*WatchReactionsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceWatchReactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceWatchReactionsClient) Recv() (*WatchReactionsResponse, error) {
+	m := new(WatchReactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) ListTrendingBlogs(ctx context.Context, in *ListTrendingBlogsRequest, opts ...grpc.CallOption) (*ListTrendingBlogsResponse, error) {
+	out := new(ListTrendingBlogsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ListTrendingBlogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) AddTranslation(ctx context.Context, in *AddTranslationRequest, opts ...grpc.CallOption) (*AddTranslationResponse, error) {
+	out := new(AddTranslationResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/AddTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) UpdateTranslation(ctx context.Context, in *UpdateTranslationRequest, opts ...grpc.CallOption) (*UpdateTranslationResponse, error) {
+	out := new(UpdateTranslationResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/UpdateTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RemoveTranslation(ctx context.Context, in *RemoveTranslationRequest, opts ...grpc.CallOption) (*RemoveTranslationResponse, error) {
+	out := new(RemoveTranslationResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/RemoveTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlogServiceServer is the server API for BlogService service.
 // All implementations must embed UnimplementedBlogServiceServer
 // for forward compatibility
@@ -110,6 +226,18 @@ type BlogServiceServer interface {
 	UpdateBlog(context.Context, *UpdateBlogRequest) (*UpdateBlogResponse, error)
 	DeleteBlog(context.Context, *DeleteBlogRequest) (*DeleteBlogResponse, error)
 	ListBlog(*ListBlogRequest, BlogService_ListBlogServer) error
+	GetBlogStats(context.Context, *GetBlogStatsRequest) (*GetBlogStatsResponse, error)
+	GetRelatedBlogs(context.Context, *GetRelatedBlogsRequest) (*GetRelatedBlogsResponse, error)
+	ReactToBlog(context.Context, *ReactToBlogRequest) (*ReactToBlogResponse, error)
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error)
+	// Sends the current counts, then every change. Bursts of changes are
+	// coalesced into a single message.
+	WatchReactions(*WatchReactionsRequest, BlogService_WatchReactionsServer) error
+	ListTrendingBlogs(context.Context, *ListTrendingBlogsRequest) (*ListTrendingBlogsResponse, error)
+	// Fails with AlreadyExists if the blog has a translation to the language.
+	AddTranslation(context.Context, *AddTranslationRequest) (*AddTranslationResponse, error)
+	UpdateTranslation(context.Context, *UpdateTranslationRequest) (*UpdateTranslationResponse, error)
+	RemoveTranslation(context.Context, *RemoveTranslationRequest) (*RemoveTranslationResponse, error)
 	mustEmbedUnimplementedBlogServiceServer()
 }
 
@@ -132,6 +260,33 @@ func (UnimplementedBlogServiceServer) DeleteBlog(context.Context, *DeleteBlogReq
 func (UnimplementedBlogServiceServer) ListBlog(*ListBlogRequest, BlogService_ListBlogServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListBlog not implemented")
 }
+func (UnimplementedBlogServiceServer) GetBlogStats(context.Context, *GetBlogStatsRequest) (*GetBlogStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlogStats not implemented")
+}
+func (UnimplementedBlogServiceServer) GetRelatedBlogs(context.Context, *GetRelatedBlogsRequest) (*GetRelatedBlogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedBlogs not implemented")
+}
+func (UnimplementedBlogServiceServer) ReactToBlog(context.Context, *ReactToBlogRequest) (*ReactToBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactToBlog not implemented")
+}
+func (UnimplementedBlogServiceServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedBlogServiceServer) WatchReactions(*WatchReactionsRequest, BlogService_WatchReactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchReactions not implemented")
+}
+func (UnimplementedBlogServiceServer) ListTrendingBlogs(context.Context, *ListTrendingBlogsRequest) (*ListTrendingBlogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrendingBlogs not implemented")
+}
+func (UnimplementedBlogServiceServer) AddTranslation(context.Context, *AddTranslationRequest) (*AddTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTranslation not implemented")
+}
+func (UnimplementedBlogServiceServer) UpdateTranslation(context.Context, *UpdateTranslationRequest) (*UpdateTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTranslation not implemented")
+}
+func (UnimplementedBlogServiceServer) RemoveTranslation(context.Context, *RemoveTranslationRequest) (*RemoveTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTranslation not implemented")
+}
 func (UnimplementedBlogServiceServer) mustEmbedUnimplementedBlogServiceServer() {}
 
 // UnsafeBlogServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -238,6 +393,171 @@ func (x *blogServiceListBlogServer) Send(m *ListBlogResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _BlogService_GetBlogStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlogStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetBlogStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/GetBlogStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetBlogStats(ctx, req.(*GetBlogStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetRelatedBlogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedBlogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetRelatedBlogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/GetRelatedBlogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetRelatedBlogs(ctx, req.(*GetRelatedBlogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ReactToBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactToBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ReactToBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ReactToBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ReactToBlog(ctx, req.(*ReactToBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/RemoveReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_WatchReactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).WatchReactions(m, &blogServiceWatchReactionsServer{stream})
+}
+
+type BlogService_WatchReactionsServer interface {
+	Send(*WatchReactionsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceWatchReactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceWatchReactionsServer) Send(m *WatchReactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_ListTrendingBlogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingBlogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListTrendingBlogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ListTrendingBlogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListTrendingBlogs(ctx, req.(*ListTrendingBlogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_AddTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).AddTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/AddTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).AddTranslation(ctx, req.(*AddTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UpdateTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdateTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/UpdateTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdateTranslation(ctx, req.(*UpdateTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RemoveTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RemoveTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/RemoveTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RemoveTranslation(ctx, req.(*RemoveTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BlogService_ServiceDesc is the grpc.ServiceDesc for BlogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +581,38 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBlog",
 			Handler:    _BlogService_DeleteBlog_Handler,
 		},
+		{
+			MethodName: "GetBlogStats",
+			Handler:    _BlogService_GetBlogStats_Handler,
+		},
+		{
+			MethodName: "GetRelatedBlogs",
+			Handler:    _BlogService_GetRelatedBlogs_Handler,
+		},
+		{
+			MethodName: "ReactToBlog",
+			Handler:    _BlogService_ReactToBlog_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _BlogService_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "ListTrendingBlogs",
+			Handler:    _BlogService_ListTrendingBlogs_Handler,
+		},
+		{
+			MethodName: "AddTranslation",
+			Handler:    _BlogService_AddTranslation_Handler,
+		},
+		{
+			MethodName: "UpdateTranslation",
+			Handler:    _BlogService_UpdateTranslation_Handler,
+		},
+		{
+			MethodName: "RemoveTranslation",
+			Handler:    _BlogService_RemoveTranslation_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -268,6 +620,981 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _BlogService_ListBlog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchReactions",
+			Handler:       _BlogService_WatchReactions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "blog/blogpb/blog.proto",
+}
+
+// WebhookServiceClient is the client API for WebhookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebhookServiceClient interface {
+	// The webhook gets the events of blog writes on this server right away,
+	// and of writes on other servers sharing the database within a minute.
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListDeliveryAttempts(ctx context.Context, in *ListDeliveryAttemptsRequest, opts ...grpc.CallOption) (*ListDeliveryAttemptsResponse, error)
+}
+
+type webhookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhookServiceClient(cc grpc.ClientConnInterface) WebhookServiceClient {
+	return &webhookServiceClient{cc}
+}
+
+func (c *webhookServiceClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error) {
+	out := new(RegisterWebhookResponse)
+	err := c.cc.Invoke(ctx, "/blog.WebhookService/RegisterWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/blog.WebhookService/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/blog.WebhookService/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListDeliveryAttempts(ctx context.Context, in *ListDeliveryAttemptsRequest, opts ...grpc.CallOption) (*ListDeliveryAttemptsResponse, error) {
+	out := new(ListDeliveryAttemptsResponse)
+	err := c.cc.Invoke(ctx, "/blog.WebhookService/ListDeliveryAttempts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhookServiceServer is the server API for WebhookService service.
+// All implementations must embed UnimplementedWebhookServiceServer
+// for forward compatibility
+type WebhookServiceServer interface {
+	// The webhook gets the events of blog writes on this server right away,
+	// and of writes on other servers sharing the database within a minute.
+	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListDeliveryAttempts(context.Context, *ListDeliveryAttemptsRequest) (*ListDeliveryAttemptsResponse, error)
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+// UnimplementedWebhookServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWebhookServiceServer struct {
+}
+
+func (UnimplementedWebhookServiceServer) RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedWebhookServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListDeliveryAttempts(context.Context, *ListDeliveryAttemptsRequest) (*ListDeliveryAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveryAttempts not implemented")
+}
+func (UnimplementedWebhookServiceServer) mustEmbedUnimplementedWebhookServiceServer() {}
+
+// UnsafeWebhookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhookServiceServer will
+// result in compilation errors.
+type UnsafeWebhookServiceServer interface {
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+func RegisterWebhookServiceServer(s grpc.ServiceRegistrar, srv WebhookServiceServer) {
+	s.RegisterService(&WebhookService_ServiceDesc, srv)
+}
+
+func _WebhookService_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.WebhookService/RegisterWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).RegisterWebhook(ctx, req.(*RegisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.WebhookService/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.WebhookService/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListDeliveryAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeliveryAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListDeliveryAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.WebhookService/ListDeliveryAttempts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListDeliveryAttempts(ctx, req.(*ListDeliveryAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhookService_ServiceDesc is the grpc.ServiceDesc for WebhookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blog.WebhookService",
+	HandlerType: (*WebhookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _WebhookService_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _WebhookService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _WebhookService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListDeliveryAttempts",
+			Handler:    _WebhookService_ListDeliveryAttempts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blog/blogpb/blog.proto",
+}
+
+// ModerationServiceClient is the client API for ModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ModerationServiceClient interface {
+	ListQuarantinedBlogs(ctx context.Context, in *ListQuarantinedBlogsRequest, opts ...grpc.CallOption) (*ListQuarantinedBlogsResponse, error)
+	// Publishes a quarantined or rejected blog.
+	ApproveBlog(ctx context.Context, in *ApproveBlogRequest, opts ...grpc.CallOption) (*ApproveBlogResponse, error)
+	RejectBlog(ctx context.Context, in *RejectBlogRequest, opts ...grpc.CallOption) (*RejectBlogResponse, error)
+}
+
+type moderationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewModerationServiceClient(cc grpc.ClientConnInterface) ModerationServiceClient {
+	return &moderationServiceClient{cc}
+}
+
+func (c *moderationServiceClient) ListQuarantinedBlogs(ctx context.Context, in *ListQuarantinedBlogsRequest, opts ...grpc.CallOption) (*ListQuarantinedBlogsResponse, error) {
+	out := new(ListQuarantinedBlogsResponse)
+	err := c.cc.Invoke(ctx, "/blog.ModerationService/ListQuarantinedBlogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ApproveBlog(ctx context.Context, in *ApproveBlogRequest, opts ...grpc.CallOption) (*ApproveBlogResponse, error) {
+	out := new(ApproveBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.ModerationService/ApproveBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) RejectBlog(ctx context.Context, in *RejectBlogRequest, opts ...grpc.CallOption) (*RejectBlogResponse, error) {
+	out := new(RejectBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.ModerationService/RejectBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModerationServiceServer is the server API for ModerationService service.
+// All implementations must embed UnimplementedModerationServiceServer
+// for forward compatibility
+type ModerationServiceServer interface {
+	ListQuarantinedBlogs(context.Context, *ListQuarantinedBlogsRequest) (*ListQuarantinedBlogsResponse, error)
+	// Publishes a quarantined or rejected blog.
+	ApproveBlog(context.Context, *ApproveBlogRequest) (*ApproveBlogResponse, error)
+	RejectBlog(context.Context, *RejectBlogRequest) (*RejectBlogResponse, error)
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+// UnimplementedModerationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedModerationServiceServer struct {
+}
+
+func (UnimplementedModerationServiceServer) ListQuarantinedBlogs(context.Context, *ListQuarantinedBlogsRequest) (*ListQuarantinedBlogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuarantinedBlogs not implemented")
+}
+func (UnimplementedModerationServiceServer) ApproveBlog(context.Context, *ApproveBlogRequest) (*ApproveBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveBlog not implemented")
+}
+func (UnimplementedModerationServiceServer) RejectBlog(context.Context, *RejectBlogRequest) (*RejectBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectBlog not implemented")
+}
+func (UnimplementedModerationServiceServer) mustEmbedUnimplementedModerationServiceServer() {}
+
+// UnsafeModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ModerationServiceServer will
+// result in compilation errors.
+type UnsafeModerationServiceServer interface {
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+func RegisterModerationServiceServer(s grpc.ServiceRegistrar, srv ModerationServiceServer) {
+	s.RegisterService(&ModerationService_ServiceDesc, srv)
+}
+
+func _ModerationService_ListQuarantinedBlogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedBlogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListQuarantinedBlogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ModerationService/ListQuarantinedBlogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListQuarantinedBlogs(ctx, req.(*ListQuarantinedBlogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ApproveBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ApproveBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ModerationService/ApproveBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ApproveBlog(ctx, req.(*ApproveBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_RejectBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).RejectBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ModerationService/RejectBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).RejectBlog(ctx, req.(*RejectBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ModerationService_ServiceDesc is the grpc.ServiceDesc for ModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blog.ModerationService",
+	HandlerType: (*ModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListQuarantinedBlogs",
+			Handler:    _ModerationService_ListQuarantinedBlogs_Handler,
+		},
+		{
+			MethodName: "ApproveBlog",
+			Handler:    _ModerationService_ApproveBlog_Handler,
+		},
+		{
+			MethodName: "RejectBlog",
+			Handler:    _ModerationService_RejectBlog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blog/blogpb/blog.proto",
+}
+
+// ReadingListServiceClient is the client API for ReadingListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReadingListServiceClient interface {
+	CreateReadingList(ctx context.Context, in *CreateReadingListRequest, opts ...grpc.CallOption) (*CreateReadingListResponse, error)
+	ListReadingLists(ctx context.Context, in *ListReadingListsRequest, opts ...grpc.CallOption) (*ListReadingListsResponse, error)
+	DeleteReadingList(ctx context.Context, in *DeleteReadingListRequest, opts ...grpc.CallOption) (*DeleteReadingListResponse, error)
+	// Fails with AlreadyExists if the blog is bookmarked already.
+	AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*AddBookmarkResponse, error)
+	MoveBookmark(ctx context.Context, in *MoveBookmarkRequest, opts ...grpc.CallOption) (*MoveBookmarkResponse, error)
+	SetBookmarkRead(ctx context.Context, in *SetBookmarkReadRequest, opts ...grpc.CallOption) (*SetBookmarkReadResponse, error)
+	RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error)
+	ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error)
+}
+
+type readingListServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReadingListServiceClient(cc grpc.ClientConnInterface) ReadingListServiceClient {
+	return &readingListServiceClient{cc}
+}
+
+func (c *readingListServiceClient) CreateReadingList(ctx context.Context, in *CreateReadingListRequest, opts ...grpc.CallOption) (*CreateReadingListResponse, error) {
+	out := new(CreateReadingListResponse)
+	err := c.cc.Invoke(ctx, "/blog.ReadingListService/CreateReadingList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingListServiceClient) ListReadingLists(ctx context.Context, in *ListReadingListsRequest, opts ...grpc.CallOption) (*ListReadingListsResponse, error) {
+	out := new(ListReadingListsResponse)
+	err := c.cc.Invoke(ctx, "/blog.ReadingListService/ListReadingLists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingListServiceClient) DeleteReadingList(ctx context.Context, in *DeleteReadingListRequest, opts ...grpc.CallOption) (*DeleteReadingListResponse, error) {
+	out := new(DeleteReadingListResponse)
+	err := c.cc.Invoke(ctx, "/blog.ReadingListService/DeleteReadingList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingListServiceClient) AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*AddBookmarkResponse, error) {
+	out := new(AddBookmarkResponse)
+	err := c.cc.Invoke(ctx, "/blog.ReadingListService/AddBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingListServiceClient) MoveBookmark(ctx context.Context, in *MoveBookmarkRequest, opts ...grpc.CallOption) (*MoveBookmarkResponse, error) {
+	out := new(MoveBookmarkResponse)
+	err := c.cc.Invoke(ctx, "/blog.ReadingListService/MoveBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingListServiceClient) SetBookmarkRead(ctx context.Context, in *SetBookmarkReadRequest, opts ...grpc.CallOption) (*SetBookmarkReadResponse, error) {
+	out := new(SetBookmarkReadResponse)
+	err := c.cc.Invoke(ctx, "/blog.ReadingListService/SetBookmarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingListServiceClient) RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error) {
+	out := new(RemoveBookmarkResponse)
+	err := c.cc.Invoke(ctx, "/blog.ReadingListService/RemoveBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingListServiceClient) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error) {
+	out := new(ListBookmarksResponse)
+	err := c.cc.Invoke(ctx, "/blog.ReadingListService/ListBookmarks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReadingListServiceServer is the server API for ReadingListService service.
+// All implementations must embed UnimplementedReadingListServiceServer
+// for forward compatibility
+type ReadingListServiceServer interface {
+	CreateReadingList(context.Context, *CreateReadingListRequest) (*CreateReadingListResponse, error)
+	ListReadingLists(context.Context, *ListReadingListsRequest) (*ListReadingListsResponse, error)
+	DeleteReadingList(context.Context, *DeleteReadingListRequest) (*DeleteReadingListResponse, error)
+	// Fails with AlreadyExists if the blog is bookmarked already.
+	AddBookmark(context.Context, *AddBookmarkRequest) (*AddBookmarkResponse, error)
+	MoveBookmark(context.Context, *MoveBookmarkRequest) (*MoveBookmarkResponse, error)
+	SetBookmarkRead(context.Context, *SetBookmarkReadRequest) (*SetBookmarkReadResponse, error)
+	RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error)
+	ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error)
+	mustEmbedUnimplementedReadingListServiceServer()
+}
+
+// UnimplementedReadingListServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReadingListServiceServer struct {
+}
+
+func (UnimplementedReadingListServiceServer) CreateReadingList(context.Context, *CreateReadingListRequest) (*CreateReadingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReadingList not implemented")
+}
+func (UnimplementedReadingListServiceServer) ListReadingLists(context.Context, *ListReadingListsRequest) (*ListReadingListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReadingLists not implemented")
+}
+func (UnimplementedReadingListServiceServer) DeleteReadingList(context.Context, *DeleteReadingListRequest) (*DeleteReadingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReadingList not implemented")
+}
+func (UnimplementedReadingListServiceServer) AddBookmark(context.Context, *AddBookmarkRequest) (*AddBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookmark not implemented")
+}
+func (UnimplementedReadingListServiceServer) MoveBookmark(context.Context, *MoveBookmarkRequest) (*MoveBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveBookmark not implemented")
+}
+func (UnimplementedReadingListServiceServer) SetBookmarkRead(context.Context, *SetBookmarkReadRequest) (*SetBookmarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBookmarkRead not implemented")
+}
+func (UnimplementedReadingListServiceServer) RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookmark not implemented")
+}
+func (UnimplementedReadingListServiceServer) ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+}
+func (UnimplementedReadingListServiceServer) mustEmbedUnimplementedReadingListServiceServer() {}
+
+// UnsafeReadingListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReadingListServiceServer will
+// result in compilation errors.
+type UnsafeReadingListServiceServer interface {
+	mustEmbedUnimplementedReadingListServiceServer()
+}
+
+func RegisterReadingListServiceServer(s grpc.ServiceRegistrar, srv ReadingListServiceServer) {
+	s.RegisterService(&ReadingListService_ServiceDesc, srv)
+}
+
+func _ReadingListService_CreateReadingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReadingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingListServiceServer).CreateReadingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ReadingListService/CreateReadingList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingListServiceServer).CreateReadingList(ctx, req.(*CreateReadingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReadingListService_ListReadingLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReadingListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingListServiceServer).ListReadingLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ReadingListService/ListReadingLists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingListServiceServer).ListReadingLists(ctx, req.(*ListReadingListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReadingListService_DeleteReadingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReadingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingListServiceServer).DeleteReadingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ReadingListService/DeleteReadingList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingListServiceServer).DeleteReadingList(ctx, req.(*DeleteReadingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReadingListService_AddBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingListServiceServer).AddBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ReadingListService/AddBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingListServiceServer).AddBookmark(ctx, req.(*AddBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReadingListService_MoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingListServiceServer).MoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ReadingListService/MoveBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingListServiceServer).MoveBookmark(ctx, req.(*MoveBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReadingListService_SetBookmarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBookmarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingListServiceServer).SetBookmarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ReadingListService/SetBookmarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingListServiceServer).SetBookmarkRead(ctx, req.(*SetBookmarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReadingListService_RemoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingListServiceServer).RemoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ReadingListService/RemoveBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingListServiceServer).RemoveBookmark(ctx, req.(*RemoveBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReadingListService_ListBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingListServiceServer).ListBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.ReadingListService/ListBookmarks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingListServiceServer).ListBookmarks(ctx, req.(*ListBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReadingListService_ServiceDesc is the grpc.ServiceDesc for ReadingListService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReadingListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blog.ReadingListService",
+	HandlerType: (*ReadingListServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReadingList",
+			Handler:    _ReadingListService_CreateReadingList_Handler,
+		},
+		{
+			MethodName: "ListReadingLists",
+			Handler:    _ReadingListService_ListReadingLists_Handler,
+		},
+		{
+			MethodName: "DeleteReadingList",
+			Handler:    _ReadingListService_DeleteReadingList_Handler,
+		},
+		{
+			MethodName: "AddBookmark",
+			Handler:    _ReadingListService_AddBookmark_Handler,
+		},
+		{
+			MethodName: "MoveBookmark",
+			Handler:    _ReadingListService_MoveBookmark_Handler,
+		},
+		{
+			MethodName: "SetBookmarkRead",
+			Handler:    _ReadingListService_SetBookmarkRead_Handler,
+		},
+		{
+			MethodName: "RemoveBookmark",
+			Handler:    _ReadingListService_RemoveBookmark_Handler,
+		},
+		{
+			MethodName: "ListBookmarks",
+			Handler:    _ReadingListService_ListBookmarks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blog/blogpb/blog.proto",
+}
+
+// SeriesServiceClient is the client API for SeriesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SeriesServiceClient interface {
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*CreateSeriesResponse, error)
+	GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*GetSeriesResponse, error)
+	DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*DeleteSeriesResponse, error)
+	// Fails with FailedPrecondition if the blog is in a series already or is
+	// by another author.
+	AddSeriesPart(ctx context.Context, in *AddSeriesPartRequest, opts ...grpc.CallOption) (*AddSeriesPartResponse, error)
+	RemoveSeriesPart(ctx context.Context, in *RemoveSeriesPartRequest, opts ...grpc.CallOption) (*RemoveSeriesPartResponse, error)
+	// Fails with Aborted if the parts changed concurrently.
+	ReorderSeries(ctx context.Context, in *ReorderSeriesRequest, opts ...grpc.CallOption) (*ReorderSeriesResponse, error)
+}
+
+type seriesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSeriesServiceClient(cc grpc.ClientConnInterface) SeriesServiceClient {
+	return &seriesServiceClient{cc}
+}
+
+func (c *seriesServiceClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*CreateSeriesResponse, error) {
+	out := new(CreateSeriesResponse)
+	err := c.cc.Invoke(ctx, "/blog.SeriesService/CreateSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*GetSeriesResponse, error) {
+	out := new(GetSeriesResponse)
+	err := c.cc.Invoke(ctx, "/blog.SeriesService/GetSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*DeleteSeriesResponse, error) {
+	out := new(DeleteSeriesResponse)
+	err := c.cc.Invoke(ctx, "/blog.SeriesService/DeleteSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) AddSeriesPart(ctx context.Context, in *AddSeriesPartRequest, opts ...grpc.CallOption) (*AddSeriesPartResponse, error) {
+	out := new(AddSeriesPartResponse)
+	err := c.cc.Invoke(ctx, "/blog.SeriesService/AddSeriesPart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) RemoveSeriesPart(ctx context.Context, in *RemoveSeriesPartRequest, opts ...grpc.CallOption) (*RemoveSeriesPartResponse, error) {
+	out := new(RemoveSeriesPartResponse)
+	err := c.cc.Invoke(ctx, "/blog.SeriesService/RemoveSeriesPart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) ReorderSeries(ctx context.Context, in *ReorderSeriesRequest, opts ...grpc.CallOption) (*ReorderSeriesResponse, error) {
+	out := new(ReorderSeriesResponse)
+	err := c.cc.Invoke(ctx, "/blog.SeriesService/ReorderSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SeriesServiceServer is the server API for SeriesService service.
+// All implementations must embed UnimplementedSeriesServiceServer
+// for forward compatibility
+type SeriesServiceServer interface {
+	CreateSeries(context.Context, *CreateSeriesRequest) (*CreateSeriesResponse, error)
+	GetSeries(context.Context, *GetSeriesRequest) (*GetSeriesResponse, error)
+	DeleteSeries(context.Context, *DeleteSeriesRequest) (*DeleteSeriesResponse, error)
+	// Fails with FailedPrecondition if the blog is in a series already or is
+	// by another author.
+	AddSeriesPart(context.Context, *AddSeriesPartRequest) (*AddSeriesPartResponse, error)
+	RemoveSeriesPart(context.Context, *RemoveSeriesPartRequest) (*RemoveSeriesPartResponse, error)
+	// Fails with Aborted if the parts changed concurrently.
+	ReorderSeries(context.Context, *ReorderSeriesRequest) (*ReorderSeriesResponse, error)
+	mustEmbedUnimplementedSeriesServiceServer()
+}
+
+// UnimplementedSeriesServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSeriesServiceServer struct {
+}
+
+func (UnimplementedSeriesServiceServer) CreateSeries(context.Context, *CreateSeriesRequest) (*CreateSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) GetSeries(context.Context, *GetSeriesRequest) (*GetSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) DeleteSeries(context.Context, *DeleteSeriesRequest) (*DeleteSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) AddSeriesPart(context.Context, *AddSeriesPartRequest) (*AddSeriesPartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSeriesPart not implemented")
+}
+func (UnimplementedSeriesServiceServer) RemoveSeriesPart(context.Context, *RemoveSeriesPartRequest) (*RemoveSeriesPartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSeriesPart not implemented")
+}
+func (UnimplementedSeriesServiceServer) ReorderSeries(context.Context, *ReorderSeriesRequest) (*ReorderSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderSeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) mustEmbedUnimplementedSeriesServiceServer() {}
+
+// UnsafeSeriesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SeriesServiceServer will
+// result in compilation errors.
+type UnsafeSeriesServiceServer interface {
+	mustEmbedUnimplementedSeriesServiceServer()
+}
+
+func RegisterSeriesServiceServer(s grpc.ServiceRegistrar, srv SeriesServiceServer) {
+	s.RegisterService(&SeriesService_ServiceDesc, srv)
+}
+
+func _SeriesService_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).CreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.SeriesService/CreateSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).CreateSeries(ctx, req.(*CreateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_GetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).GetSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.SeriesService/GetSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).GetSeries(ctx, req.(*GetSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_DeleteSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).DeleteSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.SeriesService/DeleteSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).DeleteSeries(ctx, req.(*DeleteSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_AddSeriesPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSeriesPartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).AddSeriesPart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.SeriesService/AddSeriesPart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).AddSeriesPart(ctx, req.(*AddSeriesPartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_RemoveSeriesPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSeriesPartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).RemoveSeriesPart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.SeriesService/RemoveSeriesPart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).RemoveSeriesPart(ctx, req.(*RemoveSeriesPartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_ReorderSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).ReorderSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.SeriesService/ReorderSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).ReorderSeries(ctx, req.(*ReorderSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SeriesService_ServiceDesc is the grpc.ServiceDesc for SeriesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SeriesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blog.SeriesService",
+	HandlerType: (*SeriesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSeries",
+			Handler:    _SeriesService_CreateSeries_Handler,
+		},
+		{
+			MethodName: "GetSeries",
+			Handler:    _SeriesService_GetSeries_Handler,
+		},
+		{
+			MethodName: "DeleteSeries",
+			Handler:    _SeriesService_DeleteSeries_Handler,
+		},
+		{
+			MethodName: "AddSeriesPart",
+			Handler:    _SeriesService_AddSeriesPart_Handler,
+		},
+		{
+			MethodName: "RemoveSeriesPart",
+			Handler:    _SeriesService_RemoveSeriesPart_Handler,
+		},
+		{
+			MethodName: "ReorderSeries",
+			Handler:    _SeriesService_ReorderSeries_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "blog/blogpb/blog.proto",
 }
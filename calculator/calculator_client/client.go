@@ -29,12 +29,32 @@ func main() {
 
 	// doServerStreaming(c)
 
+	// doFactorize(c, 600851475143)
+
+	// doStreamPrimes(c, 1000000000000, 1000000001000)
+
 	// doClientStreaming(c)
 
+	// doComputeStatistics(c, []float64{3, -1.5, 8, 2, 2, 10, 4.25})
+
 	// doBiDirectionalStreaming(c)
 
+	// doWindowedExtremes(c, 3, 2, []float64{-4, -1, -7, -3, -9, -2})
+
 	doErrorUnary(c)
 
+	// doComplexSquareRoot(c, -16)
+
+	// doBigPower(c, "2", "1000")
+
+	// doModInverse(c, "3", "11")
+
+	// doRationalToDecimal(c, "-22", "7")
+
+	// doEvaluate(c, "2 * (3 + sqrt(16)) / pi")
+
+	// doSession(c, []string{"x = 3", "y = x * 2", "y + 1", "f(n) = n^2 + ans", "f(x)"})
+
 }
 
 func doUnary(c calculatorpb.CalculatorServiceClient) {
@@ -83,6 +103,61 @@ func doServerStreaming(c calculatorpb.CalculatorServiceClient) {
 
 }
 
+func doFactorize(c calculatorpb.CalculatorServiceClient, number uint64) {
+
+	req := &calculatorpb.FactorizeRequest{
+		Number: number,
+	}
+
+	resStream, err := c.Factorize(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while calling Factorize RPC: %v", err)
+	}
+
+	for {
+		msg, err := resStream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			log.Fatalf("Error while reading stream: %v", err)
+		}
+
+		log.Printf("Response from Factorize: %v", msg.GetPrimeFactor())
+	}
+}
+
+func doStreamPrimes(c calculatorpb.CalculatorServiceClient, from uint64, to uint64) {
+
+	req := &calculatorpb.StreamPrimesRequest{
+		From: from,
+		To:   to,
+	}
+
+	resStream, err := c.StreamPrimes(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while calling StreamPrimes RPC: %v", err)
+	}
+
+	for {
+		msg, err := resStream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			log.Fatalf("Error while reading stream: %v", err)
+		}
+
+		log.Printf("Response from StreamPrimes: %v", msg.GetPrimes())
+	}
+}
+
 func doClientStreaming(c calculatorpb.CalculatorServiceClient) {
 
 	stream, err := c.ComputeAverage(context.Background())
@@ -124,6 +199,33 @@ func doClientStreaming(c calculatorpb.CalculatorServiceClient) {
 	fmt.Printf("ComputeAverage Response: %v\n", res)
 }
 
+func doComputeStatistics(c calculatorpb.CalculatorServiceClient, numbers []float64) {
+
+	stream, err := c.ComputeStatistics(context.Background())
+
+	if err != nil {
+		log.Fatalf("Error while calling ComputeStatistics RPC: %v", err)
+	}
+
+	for _, number := range numbers {
+		req := &calculatorpb.ComputeStatisticsRequest{
+			Number: number,
+		}
+
+		if err := stream.Send(req); err != nil {
+			log.Fatalf("Error while sending to ComputeStatistics: %v", err)
+		}
+	}
+
+	res, err := stream.CloseAndRecv()
+
+	if err != nil {
+		log.Fatalf("Error while receiving response from ComputeStatistics: %v", err)
+	}
+
+	fmt.Printf("Response from ComputeStatistics: %v\n", res)
+}
+
 func doBiDirectionalStreaming(c calculatorpb.CalculatorServiceClient) {
 	stream, err := c.FindMaximum(context.Background())
 
@@ -195,6 +297,47 @@ func doBiDirectionalStreaming(c calculatorpb.CalculatorServiceClient) {
 	<-waitc
 }
 
+func doWindowedExtremes(c calculatorpb.CalculatorServiceClient, size uint32, topK uint32, numbers []float64) {
+	stream, err := c.WindowedExtremes(context.Background())
+
+	if err != nil {
+		log.Fatalf("Error while calling WindowedExtremes: %v", err)
+	}
+
+	config := &calculatorpb.WindowedExtremesRequest{
+		Request: &calculatorpb.WindowedExtremesRequest_Config{
+			Config: &calculatorpb.WindowConfig{
+				Window: &calculatorpb.WindowConfig_Size{Size: size},
+				TopK:   topK,
+			},
+		},
+	}
+
+	if err := stream.Send(config); err != nil {
+		log.Fatalf("Error while sending request: %v", err)
+	}
+
+	for _, number := range numbers {
+		req := &calculatorpb.WindowedExtremesRequest{
+			Request: &calculatorpb.WindowedExtremesRequest_Number{Number: number},
+		}
+
+		if err := stream.Send(req); err != nil {
+			log.Fatalf("Error while sending request: %v", err)
+		}
+
+		res, err := stream.Recv()
+
+		if err != nil {
+			log.Fatalf("Error while receiving response: %v", err)
+		}
+
+		fmt.Printf("Sent %v, window: %v\n", number, res)
+	}
+
+	stream.CloseSend()
+}
+
 func doErrorUnary(c calculatorpb.CalculatorServiceClient) {
 	fmt.Println("Starting to do a SquareRoot Unary RPC")
 
@@ -224,3 +367,140 @@ func doErrorUnary(c calculatorpb.CalculatorServiceClient) {
 
 	fmt.Printf("Response from SquareRoot: %v\n", res.GetSquareRoot())
 }
+
+func doComplexSquareRoot(c calculatorpb.CalculatorServiceClient, number int32) {
+
+	req := &calculatorpb.SquareRootRequest{
+		Number:       number,
+		AllowComplex: true,
+	}
+
+	res, err := c.SquareRoot(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while calling SquareRoot: %v", err)
+	}
+
+	root := res.GetComplexRoot()
+
+	fmt.Printf("Response from SquareRoot: %v + %vi\n", root.GetReal(), root.GetImaginary())
+}
+
+func doBigPower(c calculatorpb.CalculatorServiceClient, base string, exponent string) {
+
+	req := &calculatorpb.BigPowerRequest{
+		Base:     base,
+		Exponent: exponent,
+	}
+
+	res, err := c.BigPower(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while calling BigPower RPC: %v", err)
+	}
+
+	fmt.Printf("Response from BigPower: %v\n", res.GetResult())
+}
+
+func doModInverse(c calculatorpb.CalculatorServiceClient, a string, modulus string) {
+
+	req := &calculatorpb.ModInverseRequest{
+		A:       a,
+		Modulus: modulus,
+	}
+
+	res, err := c.ModInverse(context.Background(), req)
+
+	if err != nil {
+		respErr, ok := status.FromError(err)
+
+		if ok && respErr.Code() == codes.InvalidArgument {
+			fmt.Println(respErr.Message())
+			return
+		}
+
+		log.Fatalf("Error while calling ModInverse RPC: %v", err)
+	}
+
+	fmt.Printf("Response from ModInverse: %v\n", res.GetResult())
+}
+
+func doRationalToDecimal(c calculatorpb.CalculatorServiceClient, numerator string, denominator string) {
+
+	req := &calculatorpb.RationalToDecimalRequest{
+		Value: &calculatorpb.Rational{
+			Numerator:   numerator,
+			Denominator: denominator,
+		},
+	}
+
+	res, err := c.RationalToDecimal(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while calling RationalToDecimal RPC: %v", err)
+	}
+
+	fmt.Printf("Response from RationalToDecimal: %v\n", res.GetDecimal())
+}
+
+func doEvaluate(c calculatorpb.CalculatorServiceClient, expression string) {
+
+	req := &calculatorpb.EvaluateRequest{
+		Expression: expression,
+	}
+
+	res, err := c.Evaluate(context.Background(), req)
+
+	if err != nil {
+		resErr, ok := status.FromError(err)
+
+		if !ok {
+			log.Fatalf("Error while calling Evaluate RPC: %v", err)
+		}
+
+		for _, detail := range resErr.Details() {
+			if exprErr, ok := detail.(*calculatorpb.ExpressionError); ok {
+				fmt.Println(expression)
+				fmt.Printf("%*s^ %v\n", int(exprErr.GetPosition())-1, "", exprErr.GetMessage())
+				return
+			}
+		}
+
+		log.Fatalf("Error while calling Evaluate RPC: %v", err)
+	}
+
+	fmt.Printf("Response from Evaluate: %v\n", res.GetResult())
+}
+
+func doSession(c calculatorpb.CalculatorServiceClient, statements []string) {
+	stream, err := c.Session(context.Background())
+
+	if err != nil {
+		log.Fatalf("Error while calling Session: %v", err)
+	}
+
+	for _, statement := range statements {
+		err := stream.Send(&calculatorpb.SessionRequest{
+			Statement: statement,
+		})
+
+		if err != nil {
+			log.Fatalf("Error while sending statement: %v", err)
+		}
+
+		res, err := stream.Recv()
+
+		if err != nil {
+			log.Fatalf("Error while receiving result: %v", err)
+		}
+
+		if res.GetError() != nil {
+			fmt.Printf("%v\n%*s^ %v\n", statement, int(res.GetError().GetPosition())-1, "", res.GetError().GetMessage())
+			continue
+		}
+
+		fmt.Printf("%v\n  %v\n", statement, res)
+	}
+
+	stream.CloseSend()
+}
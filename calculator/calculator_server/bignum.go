@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bigLimits bounds the work a single big number call can cause.
+type bigLimits struct {
+	// MaxDigits is the longest operand accepted, not counting the sign.
+	MaxDigits int
+	// MaxResultDigits is the longest result BigPower may compute.
+	MaxResultDigits int
+	// MaxPrimeBits is the largest operand of IsPrime and NextPrime.
+	MaxPrimeBits int
+	// Timeout bounds the time spent on a call.
+	Timeout time.Duration
+}
+
+// parseBig parses a base 10 operand, rejecting it before parsing if it is
+// longer than the limit.
+func (l bigLimits) parseBig(name string, value string) (*big.Int, error) {
+	digits := strings.TrimLeft(value, "+-")
+
+	if len(digits) > l.MaxDigits {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("%v has %d digits, at most %d are allowed", name, len(digits), l.MaxDigits),
+		)
+	}
+
+	number, ok := new(big.Int).SetString(value, 10)
+
+	if !ok {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("%v is not a base 10 integer: %q", name, value),
+		)
+	}
+
+	return number, nil
+}
+
+// parseBigPair parses the two operands of a binary operation.
+func (l bigLimits) parseBigPair(aName string, a string, bName string, b string) (*big.Int, *big.Int, error) {
+	x, err := l.parseBig(aName, a)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	y, err := l.parseBig(bName, b)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return x, y, nil
+}
+
+// contextStatus converts the error of a computation stopped by its context,
+// telling apart the server's timeout.
+func (l bigLimits) contextStatus(err error) error {
+	if err == context.DeadlineExceeded {
+		return status.Errorf(
+			codes.DeadlineExceeded,
+			fmt.Sprintf("Computation took longer than %v", l.Timeout),
+		)
+	}
+
+	return status.FromContextError(err).Err()
+}
+
+// bigDigits estimates the number of decimal digits of base^exponent for
+// |base| >= 2.
+func bigDigits(base *big.Int, exponent *big.Int) float64 {
+	mantissa := new(big.Float).SetInt(new(big.Int).Abs(base))
+	exp := mantissa.MantExp(mantissa)
+
+	m, _ := mantissa.Float64()
+	log10Base := (float64(exp) + math.Log2(m)) * math.Log10(2)
+
+	e, _ := new(big.Float).SetInt(exponent).Float64()
+
+	return e * log10Base
+}
+
+// bigPow computes base^exponent by repeated squaring, checking ctx between
+// multiplications so that a call can be abandoned once its deadline passes.
+func bigPow(ctx context.Context, base *big.Int, exponent *big.Int) (*big.Int, error) {
+	result := big.NewInt(1)
+
+	for i := exponent.BitLen() - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		result.Mul(result, result)
+
+		if exponent.Bit(i) == 1 {
+			result.Mul(result, base)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *server) BigAdd(ctx context.Context, req *calculatorpb.BigAddRequest) (*calculatorpb.BigAddResponse, error) {
+	fmt.Printf("BigAdd function was invoked with %v\n", req)
+
+	a, b, err := s.big.parseBigPair("a", req.GetA(), "b", req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.BigAddResponse{
+		Result: new(big.Int).Add(a, b).String(),
+	}
+
+	return res, nil
+}
+
+func (s *server) BigSubtract(ctx context.Context, req *calculatorpb.BigSubtractRequest) (*calculatorpb.BigSubtractResponse, error) {
+	fmt.Printf("BigSubtract function was invoked with %v\n", req)
+
+	a, b, err := s.big.parseBigPair("a", req.GetA(), "b", req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.BigSubtractResponse{
+		Result: new(big.Int).Sub(a, b).String(),
+	}
+
+	return res, nil
+}
+
+func (s *server) BigMultiply(ctx context.Context, req *calculatorpb.BigMultiplyRequest) (*calculatorpb.BigMultiplyResponse, error) {
+	fmt.Printf("BigMultiply function was invoked with %v\n", req)
+
+	a, b, err := s.big.parseBigPair("a", req.GetA(), "b", req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.BigMultiplyResponse{
+		Result: new(big.Int).Mul(a, b).String(),
+	}
+
+	return res, nil
+}
+
+func (s *server) BigDivide(ctx context.Context, req *calculatorpb.BigDivideRequest) (*calculatorpb.BigDivideResponse, error) {
+	fmt.Printf("BigDivide function was invoked with %v\n", req)
+
+	dividend, divisor, err := s.big.parseBigPair("dividend", req.GetDividend(), "divisor", req.GetDivisor())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if divisor.Sign() == 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot divide by zero",
+		)
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(dividend, divisor, new(big.Int))
+
+	res := &calculatorpb.BigDivideResponse{
+		Quotient:  quotient.String(),
+		Remainder: remainder.String(),
+	}
+
+	return res, nil
+}
+
+func (s *server) BigPower(ctx context.Context, req *calculatorpb.BigPowerRequest) (*calculatorpb.BigPowerResponse, error) {
+	fmt.Printf("BigPower function was invoked with %v\n", req)
+
+	base, exponent, err := s.big.parseBigPair("base", req.GetBase(), "exponent", req.GetExponent())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if exponent.Sign() < 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received a negative exponent: %v", exponent),
+		)
+	}
+
+	// 0, 1 and -1 stay small whatever the exponent, the rest grows by about
+	// log10(|base|) digits per step.
+	if base.CmpAbs(big.NewInt(1)) > 0 {
+		if digits := bigDigits(base, exponent); digits > float64(s.big.MaxResultDigits) {
+			return nil, status.Errorf(
+				codes.OutOfRange,
+				fmt.Sprintf("Result would have about %.0f digits, at most %d are allowed", digits, s.big.MaxResultDigits),
+			)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.big.Timeout)
+
+	defer cancel()
+
+	result, err := bigPow(ctx, base, exponent)
+
+	if err != nil {
+		return nil, s.big.contextStatus(err)
+	}
+
+	res := &calculatorpb.BigPowerResponse{
+		Result: result.String(),
+	}
+
+	return res, nil
+}
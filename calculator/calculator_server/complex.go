@@ -0,0 +1,351 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRootDegree bounds the n of ComplexRoots, which returns n roots.
+const maxRootDegree = 1024
+
+// parseComplex reads a complex operand, treating a missing one as zero like
+// the other numeric fields.
+func parseComplex(name string, value *calculatorpb.Complex) (complex128, error) {
+	re, im := value.GetReal(), value.GetImaginary()
+
+	if math.IsNaN(re) || math.IsInf(re, 0) || math.IsNaN(im) || math.IsInf(im, 0) {
+		return 0, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("%v is not finite: %v", name, complex(re, im)),
+		)
+	}
+
+	return complex(re, im), nil
+}
+
+func parseComplexPair(aName string, a *calculatorpb.Complex, bName string, b *calculatorpb.Complex) (complex128, complex128, error) {
+	x, err := parseComplex(aName, a)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	y, err := parseComplex(bName, b)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return x, y, nil
+}
+
+// complexMessage converts a result, which may have overflowed.
+func complexMessage(value complex128) (*calculatorpb.Complex, error) {
+	if cmplx.IsNaN(value) || cmplx.IsInf(value) {
+		return nil, status.Errorf(
+			codes.OutOfRange,
+			"Result is not finite",
+		)
+	}
+
+	return &calculatorpb.Complex{
+		Real:      real(value),
+		Imaginary: imag(value),
+	}, nil
+}
+
+// rect is cmplx.Rect without the rounding noise left on an axis, so that for
+// example the polar form of 2i at pi/2 gives 2i rather than 1.2e-16+2i.
+// Components smaller than the rounding error of the magnitude become zero.
+func rect(magnitude float64, phase float64) complex128 {
+	value := cmplx.Rect(magnitude, phase)
+	re, im := real(value), imag(value)
+	noise := magnitude * 1e-15
+
+	if math.Abs(re) <= noise {
+		re = 0
+	}
+
+	if math.Abs(im) <= noise {
+		im = 0
+	}
+
+	return complex(re, im)
+}
+
+// exponent returns the binary exponent of the larger component of value, so
+// that scaling value by 2^-exponent leaves components of at most 1 in
+// magnitude. Scaling by a power of 2 is exact unless a component underflows,
+// which only loses digits far below those of the other component.
+func exponent(value complex128) int {
+	_, exp := math.Frexp(math.Max(math.Abs(real(value)), math.Abs(imag(value))))
+
+	return exp
+}
+
+func scale(value complex128, exp int) complex128 {
+	return complex(math.Ldexp(real(value), exp), math.Ldexp(imag(value), exp))
+}
+
+// complexDivide divides with Smith's algorithm on operands scaled to at most
+// 1, so that no intermediate step overflows unless the quotient does. The
+// built-in division gives NaN for (1e308+1e308i)/(1e308+1e308i).
+func complexDivide(dividend complex128, divisor complex128) complex128 {
+	dividendExp, divisorExp := exponent(dividend), exponent(divisor)
+
+	dividend = scale(dividend, -dividendExp)
+	divisor = scale(divisor, -divisorExp)
+
+	a, b := real(dividend), imag(dividend)
+	c, d := real(divisor), imag(divisor)
+
+	var re, im float64
+
+	if math.Abs(c) >= math.Abs(d) {
+		ratio := d / c
+		denominator := c + d*ratio
+		re, im = (a+b*ratio)/denominator, (b-a*ratio)/denominator
+	} else {
+		ratio := c / d
+		denominator := c*ratio + d
+		re, im = (a*ratio+b)/denominator, (b*ratio-a)/denominator
+	}
+
+	return scale(complex(re, im), dividendExp-divisorExp)
+}
+
+// complexRoots returns the n-th roots of value, starting with the principal
+// one. The magnitude is taken on value scaled to at most 1, since it can
+// overflow when the roots do not.
+func complexRoots(value complex128, n int) []complex128 {
+	exp := exponent(value)
+	// 2^exp = 2^(n*quotient) * 2^remainder, whose n-th root is 2^quotient,
+	// applied exactly at the end, times 2^(remainder/n).
+	quotient, remainder := exp/n, exp%n
+
+	magnitude := math.Pow(cmplx.Abs(scale(value, -exp)), 1/float64(n)) * math.Exp2(float64(remainder)/float64(n))
+	phase := cmplx.Phase(value)
+
+	roots := make([]complex128, n)
+
+	for k := range roots {
+		roots[k] = scale(rect(magnitude, (phase+2*math.Pi*float64(k))/float64(n)), quotient)
+	}
+
+	return roots
+}
+
+func (*server) ComplexAdd(ctx context.Context, req *calculatorpb.ComplexAddRequest) (*calculatorpb.ComplexAddResponse, error) {
+	fmt.Printf("ComplexAdd function was invoked with %v\n", req)
+
+	a, b, err := parseComplexPair("a", req.GetA(), "b", req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := complexMessage(a + b)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.ComplexAddResponse{
+		Result: result,
+	}
+
+	return res, nil
+}
+
+func (*server) ComplexMultiply(ctx context.Context, req *calculatorpb.ComplexMultiplyRequest) (*calculatorpb.ComplexMultiplyResponse, error) {
+	fmt.Printf("ComplexMultiply function was invoked with %v\n", req)
+
+	a, b, err := parseComplexPair("a", req.GetA(), "b", req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := complexMessage(a * b)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.ComplexMultiplyResponse{
+		Result: result,
+	}
+
+	return res, nil
+}
+
+func (*server) ComplexDivide(ctx context.Context, req *calculatorpb.ComplexDivideRequest) (*calculatorpb.ComplexDivideResponse, error) {
+	fmt.Printf("ComplexDivide function was invoked with %v\n", req)
+
+	dividend, divisor, err := parseComplexPair("dividend", req.GetDividend(), "divisor", req.GetDivisor())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if divisor == 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot divide by zero",
+		)
+	}
+
+	result, err := complexMessage(complexDivide(dividend, divisor))
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.ComplexDivideResponse{
+		Result: result,
+	}
+
+	return res, nil
+}
+
+func (*server) ComplexMagnitude(ctx context.Context, req *calculatorpb.ComplexMagnitudeRequest) (*calculatorpb.ComplexMagnitudeResponse, error) {
+	fmt.Printf("ComplexMagnitude function was invoked with %v\n", req)
+
+	value, err := parseComplex("value", req.GetValue())
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Abs avoids overflowing on the squares, but the magnitude itself can.
+	magnitude := cmplx.Abs(value)
+
+	if math.IsInf(magnitude, 0) {
+		return nil, status.Errorf(
+			codes.OutOfRange,
+			"Result is not finite",
+		)
+	}
+
+	res := &calculatorpb.ComplexMagnitudeResponse{
+		Magnitude: magnitude,
+	}
+
+	return res, nil
+}
+
+func (*server) ComplexPhase(ctx context.Context, req *calculatorpb.ComplexPhaseRequest) (*calculatorpb.ComplexPhaseResponse, error) {
+	fmt.Printf("ComplexPhase function was invoked with %v\n", req)
+
+	value, err := parseComplex("value", req.GetValue())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.ComplexPhaseResponse{
+		Phase: cmplx.Phase(value),
+	}
+
+	return res, nil
+}
+
+func (*server) ComplexToPolar(ctx context.Context, req *calculatorpb.ComplexToPolarRequest) (*calculatorpb.ComplexToPolarResponse, error) {
+	fmt.Printf("ComplexToPolar function was invoked with %v\n", req)
+
+	value, err := parseComplex("value", req.GetValue())
+
+	if err != nil {
+		return nil, err
+	}
+
+	magnitude, phase := cmplx.Polar(value)
+
+	if math.IsInf(magnitude, 0) {
+		return nil, status.Errorf(
+			codes.OutOfRange,
+			"Result is not finite",
+		)
+	}
+
+	res := &calculatorpb.ComplexToPolarResponse{
+		Magnitude: magnitude,
+		Phase:     phase,
+	}
+
+	return res, nil
+}
+
+func (*server) ComplexFromPolar(ctx context.Context, req *calculatorpb.ComplexFromPolarRequest) (*calculatorpb.ComplexFromPolarResponse, error) {
+	fmt.Printf("ComplexFromPolar function was invoked with %v\n", req)
+
+	magnitude, phase := req.GetMagnitude(), req.GetPhase()
+
+	if math.IsNaN(magnitude) || math.IsInf(magnitude, 0) || math.IsNaN(phase) || math.IsInf(phase, 0) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received a magnitude or phase that is not finite: %v, %v", magnitude, phase),
+		)
+	}
+
+	if magnitude < 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received a negative magnitude: %v", magnitude),
+		)
+	}
+
+	result, err := complexMessage(rect(magnitude, phase))
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.ComplexFromPolarResponse{
+		Result: result,
+	}
+
+	return res, nil
+}
+
+func (*server) ComplexRoots(ctx context.Context, req *calculatorpb.ComplexRootsRequest) (*calculatorpb.ComplexRootsResponse, error) {
+	fmt.Printf("ComplexRoots function was invoked with %v\n", req)
+
+	value, err := parseComplex("value", req.GetValue())
+
+	if err != nil {
+		return nil, err
+	}
+
+	n := req.GetN()
+
+	if n < 1 || n > maxRootDegree {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("n must be between 1 and %v, received %v", maxRootDegree, n),
+		)
+	}
+
+	res := &calculatorpb.ComplexRootsResponse{}
+
+	for _, root := range complexRoots(value, int(n)) {
+		message, err := complexMessage(root)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Roots = append(res.Roots, message)
+	}
+
+	res.Principal = res.Roots[0]
+
+	return res, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// closeTo reports whether got is within a relative error of 1e-14 of want,
+// measured against the magnitude of want.
+func closeTo(got complex128, want complex128) bool {
+	scale := math.Max(math.Abs(real(want)), math.Abs(imag(want)))
+
+	return math.Abs(real(got)-real(want)) <= 1e-14*scale && math.Abs(imag(got)-imag(want)) <= 1e-14*scale
+}
+
+func TestComplexDivide(t *testing.T) {
+	tests := []struct {
+		name     string
+		dividend complex128
+		divisor  complex128
+		want     complex128
+	}{
+		{name: "small", dividend: 4 + 2i, divisor: 1 + 1i, want: 3 - 1i},
+		{name: "imaginary divisor", dividend: 1, divisor: 2i, want: -0.5i},
+		{name: "huge operands", dividend: 1e308 + 1e308i, divisor: 1e308 + 1e308i, want: 1},
+		{name: "huge divisor", dividend: 1 + 1i, divisor: 1e308 - 1e308i, want: 1e-308i},
+		{name: "tiny operands", dividend: 3e-308 + 4e-308i, divisor: 1e-308i, want: 4 - 3i},
+		{name: "tiny divisor", dividend: 1e154 + 1e154i, divisor: 1e-154, want: 1e308 + 1e308i},
+		{name: "mixed magnitudes", dividend: 1e300 + 1e-300i, divisor: 1e-300 + 1e300i, want: -1i},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := complexDivide(tt.dividend, tt.divisor); !closeTo(got, tt.want) {
+				t.Errorf("complexDivide(%v, %v) = %v, want %v", tt.dividend, tt.divisor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComplexRoots(t *testing.T) {
+	tests := []struct {
+		name  string
+		value complex128
+		n     int
+		want  complex128
+	}{
+		{name: "square root of -4", value: -4, n: 2, want: 2i},
+		{name: "cube root of 8", value: 8, n: 3, want: 2},
+		// The magnitude of 1.2e308+1.6e308i is 2e308, past the largest
+		// float64.
+		{name: "magnitude overflows", value: 1.2e308 + 1.6e308i, n: 2, want: complex(math.Sqrt(1.6e308), math.Sqrt(0.4e308))},
+		{name: "tiny", value: 3e-300 + 4e-300i, n: 2, want: 2e-150 + 1e-150i},
+		{name: "negative exponent", value: 1e-300, n: 7, want: complex(math.Pow(1e-300, 1.0/7), 0)},
+		{name: "highest degree", value: 1e308, n: maxRootDegree, want: complex(math.Pow(1e308, 1.0/maxRootDegree), 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roots := complexRoots(tt.value, tt.n)
+
+			if len(roots) != tt.n {
+				t.Fatalf("complexRoots(%v, %d) returned %d roots", tt.value, tt.n, len(roots))
+			}
+
+			if !closeTo(roots[0], tt.want) {
+				t.Errorf("complexRoots(%v, %d)[0] = %v, want %v", tt.value, tt.n, roots[0], tt.want)
+			}
+
+			// The roots are evenly spaced on a circle around zero.
+			for k, root := range roots {
+				want := tt.want * cmplx.Rect(1, 2*math.Pi*float64(k)/float64(tt.n))
+
+				if math.Abs(cmplx.Abs(root-want)) > 1e-13*cmplx.Abs(tt.want) {
+					t.Fatalf("complexRoots(%v, %d)[%d] = %v, want %v", tt.value, tt.n, k, root, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,373 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var constants = map[string]float64{
+	"pi":  math.Pi,
+	"e":   math.E,
+	"tau": 2 * math.Pi,
+	"phi": math.Phi,
+}
+
+// builtin is a function callable from expressions. maxArgs is -1 for
+// functions taking any number of arguments.
+type builtin struct {
+	minArgs int
+	maxArgs int
+	fn      func(args []float64) float64
+}
+
+func unaryBuiltin(fn func(x float64) float64) builtin {
+	return builtin{
+		minArgs: 1,
+		maxArgs: 1,
+		fn:      func(args []float64) float64 { return fn(args[0]) },
+	}
+}
+
+func binaryBuiltin(fn func(x float64, y float64) float64) builtin {
+	return builtin{
+		minArgs: 2,
+		maxArgs: 2,
+		fn:      func(args []float64) float64 { return fn(args[0], args[1]) },
+	}
+}
+
+func foldBuiltin(fn func(x float64, y float64) float64) builtin {
+	return builtin{
+		minArgs: 1,
+		maxArgs: -1,
+		fn: func(args []float64) float64 {
+			result := args[0]
+
+			for _, arg := range args[1:] {
+				result = fn(result, arg)
+			}
+
+			return result
+		},
+	}
+}
+
+var builtins = map[string]builtin{
+	"abs":   unaryBuiltin(math.Abs),
+	"ceil":  unaryBuiltin(math.Ceil),
+	"floor": unaryBuiltin(math.Floor),
+	"round": unaryBuiltin(math.Round),
+	"sqrt":  unaryBuiltin(math.Sqrt),
+	"cbrt":  unaryBuiltin(math.Cbrt),
+	"exp":   unaryBuiltin(math.Exp),
+	"ln":    unaryBuiltin(math.Log),
+	"log": {
+		minArgs: 1,
+		maxArgs: 2,
+		fn: func(args []float64) float64 {
+			if len(args) == 2 {
+				return math.Log(args[0]) / math.Log(args[1])
+			}
+
+			return math.Log(args[0])
+		},
+	},
+	"log2":  unaryBuiltin(math.Log2),
+	"log10": unaryBuiltin(math.Log10),
+	"pow":   binaryBuiltin(math.Pow),
+	"hypot": binaryBuiltin(math.Hypot),
+	"min":   foldBuiltin(math.Min),
+	"max":   foldBuiltin(math.Max),
+	"sin":   unaryBuiltin(math.Sin),
+	"cos":   unaryBuiltin(math.Cos),
+	"tan":   unaryBuiltin(math.Tan),
+	"asin":  unaryBuiltin(math.Asin),
+	"acos":  unaryBuiltin(math.Acos),
+	"atan":  unaryBuiltin(math.Atan),
+	"atan2": binaryBuiltin(math.Atan2),
+	"sinh":  unaryBuiltin(math.Sinh),
+	"cosh":  unaryBuiltin(math.Cosh),
+	"tanh":  unaryBuiltin(math.Tanh),
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// checkFinite turns NaN and infinite results into errors, so that for example
+// sqrt(-1) is reported instead of returned.
+func checkFinite(value float64, pos int, describe func() string) (float64, error) {
+	if math.IsNaN(value) {
+		return 0, errorAt(pos, "%s is undefined", describe())
+	}
+
+	if math.IsInf(value, 0) {
+		return 0, errorAt(pos, "%s is not finite", describe())
+	}
+
+	return value, nil
+}
+
+// evaluatorCheckInterval is how many nodes are evaluated between checks of
+// the evaluator's context.
+const evaluatorCheckInterval = 1024
+
+// evaluator computes the value of parsed expressions.
+type evaluator struct {
+	// variables are looked up before the constants.
+	variables map[string]float64
+	// locals hold the arguments of the user function being evaluated and are
+	// looked up before the variables.
+	locals    map[string]float64
+	functions map[string]*userFunction
+	// ctx, if set, stops evaluations that run past its deadline.
+	ctx   context.Context
+	steps int
+}
+
+func (e *evaluator) eval(n node) (float64, error) {
+	e.steps++
+
+	if e.ctx != nil && e.steps%evaluatorCheckInterval == 0 && e.ctx.Err() != nil {
+		return 0, errorAt(n.position(), "evaluation was stopped: %v", e.ctx.Err())
+	}
+
+	switch n := n.(type) {
+	case *numberNode:
+		return n.value, nil
+	case *identNode:
+		if value, ok := e.locals[n.name]; ok {
+			return value, nil
+		}
+
+		if value, ok := e.variables[n.name]; ok {
+			return value, nil
+		}
+
+		if value, ok := constants[n.name]; ok {
+			return value, nil
+		}
+
+		return 0, errorAt(n.pos, "unknown variable %q", n.name)
+	case *unaryNode:
+		operand, err := e.eval(n.operand)
+
+		if err != nil {
+			return 0, err
+		}
+
+		if n.op == "-" {
+			return -operand, nil
+		}
+
+		return operand, nil
+	case *binaryNode:
+		return e.evalBinary(n)
+	case *callNode:
+		return e.evalCall(n)
+	}
+
+	return 0, errorAt(n.position(), "cannot evaluate %T", n)
+}
+
+func (e *evaluator) evalBinary(n *binaryNode) (float64, error) {
+	left, err := e.eval(n.left)
+
+	if err != nil {
+		return 0, err
+	}
+
+	right, err := e.eval(n.right)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var result float64
+
+	switch n.op {
+	case "+":
+		result = left + right
+	case "-":
+		result = left - right
+	case "*":
+		result = left * right
+	case "/":
+		if right == 0 {
+			return 0, errorAt(n.pos, "division by zero")
+		}
+
+		result = left / right
+	case "%":
+		if right == 0 {
+			return 0, errorAt(n.pos, "modulo by zero")
+		}
+
+		result = math.Mod(left, right)
+	case "^":
+		result = math.Pow(left, right)
+	default:
+		return 0, errorAt(n.pos, "unknown operator %q", n.op)
+	}
+
+	return checkFinite(result, n.pos, func() string {
+		return fmt.Sprintf("%s %s %s", formatNumber(left), n.op, formatNumber(right))
+	})
+}
+
+func (e *evaluator) evalArgs(n *callNode) ([]float64, error) {
+	args := make([]float64, len(n.args))
+
+	for i, arg := range n.args {
+		value, err := e.eval(arg)
+
+		if err != nil {
+			return nil, err
+		}
+
+		args[i] = value
+	}
+
+	return args, nil
+}
+
+func (e *evaluator) evalCall(n *callNode) (float64, error) {
+	if userFn, ok := e.functions[n.name]; ok {
+		return e.evalUserCall(n, userFn)
+	}
+
+	fn, ok := builtins[n.name]
+
+	if !ok {
+		return 0, errorAt(n.pos, "unknown function %q", n.name)
+	}
+
+	if len(n.args) < fn.minArgs || (fn.maxArgs >= 0 && len(n.args) > fn.maxArgs) {
+		return 0, errorAt(n.pos, "%s takes %s, got %d", n.name, describeArity(fn.minArgs, fn.maxArgs), len(n.args))
+	}
+
+	args, err := e.evalArgs(n)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return checkFinite(fn.fn(args), n.pos, func() string {
+		formatted := make([]string, len(args))
+
+		for i, arg := range args {
+			formatted[i] = formatNumber(arg)
+		}
+
+		return fmt.Sprintf("%s(%s)", n.name, strings.Join(formatted, ", "))
+	})
+}
+
+// evalUserCall evaluates the body of a user function with its parameters
+// bound to the arguments. Errors inside the body are reported at the call,
+// since the body is not part of the expression being evaluated.
+func (e *evaluator) evalUserCall(n *callNode, fn *userFunction) (float64, error) {
+	if len(n.args) != len(fn.params) {
+		return 0, errorAt(n.pos, "%s takes %s, got %d", n.name, describeArity(len(fn.params), len(fn.params)), len(n.args))
+	}
+
+	args, err := e.evalArgs(n)
+
+	if err != nil {
+		return 0, err
+	}
+
+	locals := make(map[string]float64, len(args))
+
+	for i, param := range fn.params {
+		locals[param] = args[i]
+	}
+
+	saved := e.locals
+	e.locals = locals
+
+	value, err := e.eval(fn.body)
+
+	e.locals = saved
+
+	if exprErr, ok := err.(*expressionError); ok {
+		return 0, errorAt(n.pos, "in %s: %s", n.name, exprErr.msg)
+	}
+
+	return value, err
+}
+
+func describeArity(minArgs int, maxArgs int) string {
+	plural := func(n int) string {
+		if n == 1 {
+			return "1 argument"
+		}
+
+		return fmt.Sprintf("%d arguments", n)
+	}
+
+	switch {
+	case maxArgs < 0:
+		return "at least " + plural(minArgs)
+	case minArgs == maxArgs:
+		return plural(minArgs)
+	}
+
+	return fmt.Sprintf("%d to %s", minArgs, plural(maxArgs))
+}
+
+// expressionStatus converts an error from parsing or evaluating an expression
+// to an InvalidArgument status carrying its position.
+func expressionStatus(err error) error {
+	exprErr, ok := err.(*expressionError)
+
+	if !ok {
+		return status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("Internal error: %v", err),
+		)
+	}
+
+	st := status.New(codes.InvalidArgument, exprErr.Error())
+
+	detailed, detailErr := st.WithDetails(&calculatorpb.ExpressionError{
+		Position: int32(exprErr.pos + 1),
+		Message:  exprErr.msg,
+	})
+
+	if detailErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+func (*server) Evaluate(ctx context.Context, req *calculatorpb.EvaluateRequest) (*calculatorpb.EvaluateResponse, error) {
+	fmt.Printf("Evaluate function was invoked with %v\n", req)
+
+	tree, err := parseExpression(req.GetExpression())
+
+	if err != nil {
+		return nil, expressionStatus(err)
+	}
+
+	result, err := (&evaluator{}).eval(tree)
+
+	if err != nil {
+		return nil, expressionStatus(err)
+	}
+
+	res := &calculatorpb.EvaluateResponse{
+		Result: result,
+	}
+
+	return res, nil
+}
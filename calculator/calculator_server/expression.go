@@ -0,0 +1,387 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Limits keeping a single expression cheap to parse.
+const (
+	maxExpressionLength = 4096
+	maxExpressionDepth  = 100
+)
+
+// expressionError is a syntax or evaluation error. pos counts runes from the
+// start of the expression.
+type expressionError struct {
+	pos int
+	msg string
+}
+
+func (e *expressionError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.msg, e.pos+1)
+}
+
+func errorAt(pos int, format string, args ...interface{}) error {
+	return &expressionError{pos: pos, msg: fmt.Sprintf(format, args...)}
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenAssign
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	pos   int
+	value float64
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// tokenize splits an expression into tokens, ending with a tokenEnd one
+// positioned just past the input.
+func tokenize(input string) ([]token, error) {
+	runes := []rune(input)
+
+	if len(runes) > maxExpressionLength {
+		return nil, errorAt(maxExpressionLength, "expression is longer than %d characters", maxExpressionLength)
+	}
+
+	tokens := []token{}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case isDigit(r) || r == '.':
+			for i < len(runes) && isDigit(runes[i]) {
+				i++
+			}
+
+			if i < len(runes) && runes[i] == '.' {
+				i++
+
+				for i < len(runes) && isDigit(runes[i]) {
+					i++
+				}
+			}
+
+			// An e that is not followed by digits is left for the constant.
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+
+				if j < len(runes) && isDigit(runes[j]) {
+					i = j
+
+					for i < len(runes) && isDigit(runes[i]) {
+						i++
+					}
+				}
+			}
+
+			text := string(runes[start:i])
+			value, err := strconv.ParseFloat(text, 64)
+
+			if errors.Is(err, strconv.ErrRange) {
+				return nil, errorAt(start, "number %s is out of range", text)
+			}
+
+			if err != nil {
+				return nil, errorAt(start, "invalid number %q", text)
+			}
+
+			tokens = append(tokens, token{kind: tokenNumber, text: text, pos: start, value: value})
+		case unicode.IsLetter(r) || r == '_':
+			for i < len(runes) && isIdentRune(runes[i]) {
+				i++
+			}
+
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+		case strings.ContainsRune("+-*/%^", r):
+			i++
+			tokens = append(tokens, token{kind: tokenOperator, text: string(r), pos: start})
+		case r == '(':
+			i++
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", pos: start})
+		case r == ')':
+			i++
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", pos: start})
+		case r == ',':
+			i++
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: start})
+		case r == '=':
+			i++
+			tokens = append(tokens, token{kind: tokenAssign, text: "=", pos: start})
+		default:
+			return nil, errorAt(start, "unexpected character %q", r)
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEnd, pos: len(runes)})
+
+	return tokens, nil
+}
+
+// node is an element of a parsed expression.
+type node interface {
+	position() int
+}
+
+type numberNode struct {
+	pos   int
+	value float64
+}
+
+type identNode struct {
+	pos  int
+	name string
+}
+
+type unaryNode struct {
+	pos     int
+	op      string
+	operand node
+}
+
+type binaryNode struct {
+	pos   int
+	op    string
+	left  node
+	right node
+}
+
+type callNode struct {
+	pos  int
+	name string
+	args []node
+}
+
+func (n *numberNode) position() int { return n.pos }
+func (n *identNode) position() int  { return n.pos }
+func (n *unaryNode) position() int  { return n.pos }
+func (n *binaryNode) position() int { return n.pos }
+func (n *callNode) position() int   { return n.pos }
+
+// Binary operators bind tighter the higher their precedence. Unary minus sits
+// between multiplication and powers, so -2^2 is -(2^2) and 2^-1 is allowed.
+var binaryPrecedence = map[string]int{
+	"+": 1,
+	"-": 1,
+	"*": 2,
+	"/": 2,
+	"%": 2,
+	"^": 4,
+}
+
+const unaryPrecedence = 3
+
+// parser is a precedence climbing parser over the tokens of an expression.
+type parser struct {
+	tokens []token
+	next   int
+	depth  int
+}
+
+// parseExpression parses a complete expression.
+func parseExpression(input string) (node, error) {
+	tokens, err := tokenize(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	return p.parseAll()
+}
+
+// parseAll parses an expression that must span the remaining tokens.
+func (p *parser) parseAll() (node, error) {
+	tree, err := p.expression(0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEnd {
+		return nil, p.unexpected(tok)
+	}
+
+	return tree, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) unexpected(tok token) error {
+	if tok.kind == tokenEnd {
+		return errorAt(tok.pos, "unexpected end of expression")
+	}
+
+	return errorAt(tok.pos, "unexpected %q", tok.text)
+}
+
+func (p *parser) expect(kind tokenKind, text string) error {
+	tok := p.peek()
+
+	if tok.kind != kind {
+		if tok.kind == tokenEnd {
+			return errorAt(tok.pos, "missing %q", text)
+		}
+
+		return errorAt(tok.pos, "expected %q but found %q", text, tok.text)
+	}
+
+	p.next++
+
+	return nil
+}
+
+// expression parses operands joined by binary operators whose precedence is at
+// least minPrecedence.
+func (p *parser) expression(minPrecedence int) (node, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+
+	if p.depth > maxExpressionDepth {
+		return nil, errorAt(p.peek().pos, "expression is nested more than %d levels deep", maxExpressionDepth)
+	}
+
+	left, err := p.unary()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok := p.peek()
+		precedence, ok := binaryPrecedence[tok.text]
+
+		if tok.kind != tokenOperator || !ok || precedence < minPrecedence {
+			return left, nil
+		}
+
+		p.next++
+
+		// Powers are right associative: 2^3^2 is 2^(3^2).
+		nextPrecedence := precedence + 1
+
+		if tok.text == "^" {
+			nextPrecedence = precedence
+		}
+
+		right, err := p.expression(nextPrecedence)
+
+		if err != nil {
+			return nil, err
+		}
+
+		left = &binaryNode{pos: tok.pos, op: tok.text, left: left, right: right}
+	}
+}
+
+func (p *parser) unary() (node, error) {
+	tok := p.peek()
+
+	if tok.kind == tokenOperator && (tok.text == "-" || tok.text == "+") {
+		p.next++
+
+		operand, err := p.expression(unaryPrecedence)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &unaryNode{pos: tok.pos, op: tok.text, operand: operand}, nil
+	}
+
+	return p.primary()
+}
+
+func (p *parser) primary() (node, error) {
+	tok := p.peek()
+
+	switch tok.kind {
+	case tokenNumber:
+		p.next++
+
+		return &numberNode{pos: tok.pos, value: tok.value}, nil
+	case tokenIdent:
+		p.next++
+
+		if p.peek().kind != tokenLeftParen {
+			return &identNode{pos: tok.pos, name: tok.text}, nil
+		}
+
+		p.next++
+
+		args := []node{}
+
+		if p.peek().kind != tokenRightParen {
+			for {
+				arg, err := p.expression(0)
+
+				if err != nil {
+					return nil, err
+				}
+
+				args = append(args, arg)
+
+				if p.peek().kind != tokenComma {
+					break
+				}
+
+				p.next++
+			}
+		}
+
+		if err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+
+		return &callNode{pos: tok.pos, name: tok.text, args: args}, nil
+	case tokenLeftParen:
+		p.next++
+
+		inner, err := p.expression(0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+
+		return inner, nil
+	}
+
+	return nil, p.unexpected(tok)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseExpressionErrorPositions(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{input: "1 +", pos: 3, msg: "unexpected end of expression"},
+		{input: "1 + * 2", pos: 4, msg: `unexpected "*"`},
+		{input: "1 2", pos: 2, msg: `unexpected "2"`},
+		{input: "(1 + 2", pos: 6, msg: `missing ")"`},
+		{input: "max(1 2)", pos: 6, msg: `expected ")" but found "2"`},
+		{input: "2 $ 3", pos: 2, msg: `unexpected character '$'`},
+		{input: "é + $", pos: 4, msg: `unexpected character '$'`},
+		{input: "1 + 1e999", pos: 4, msg: "number 1e999 is out of range"},
+		{input: "1 + .", pos: 4, msg: `invalid number "."`},
+		{input: strings.Repeat("(", maxExpressionDepth+1) + "1", pos: maxExpressionDepth, msg: "nested more than"},
+		{input: strings.Repeat("1", maxExpressionLength+1), pos: maxExpressionLength, msg: "longer than"},
+	}
+
+	for _, tt := range tests {
+		name := tt.input
+
+		if len(name) > 20 {
+			name = name[:20]
+		}
+
+		t.Run(name, func(t *testing.T) {
+			_, err := parseExpression(tt.input)
+
+			exprErr, ok := err.(*expressionError)
+
+			if !ok {
+				t.Fatalf("parseExpression(%q): got %v, want an expressionError", tt.input, err)
+			}
+
+			if exprErr.pos != tt.pos || !strings.Contains(exprErr.msg, tt.msg) {
+				t.Errorf("parseExpression(%q): got %q at %d, want %q at %d", tt.input, exprErr.msg, exprErr.pos, tt.msg, tt.pos)
+			}
+		})
+	}
+}
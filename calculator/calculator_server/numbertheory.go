@@ -0,0 +1,358 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bigPrimeRounds is the number of Miller-Rabin rounds run on top of the
+// Baillie-PSW test for numbers above 64 bits.
+const bigPrimeRounds = 20
+
+// isProbablePrime is exact for numbers that fit in 64 bits. Larger numbers
+// pass the Baillie-PSW test of ProbablyPrime, which rejects almost every
+// composite, before the Miller-Rabin rounds, which check ctx as they go.
+func isProbablePrime(ctx context.Context, n *big.Int) (bool, error) {
+	if n.Sign() <= 0 {
+		return false, nil
+	}
+
+	if n.IsUint64() {
+		return isPrime64(n.Uint64()), nil
+	}
+
+	if !n.ProbablyPrime(0) {
+		return false, nil
+	}
+
+	// Seeded from n like ProbablyPrime, so that answers are reproducible.
+	rng := rand.New(rand.NewSource(int64(new(big.Int).And(n, big.NewInt(math.MaxInt64)).Int64())))
+	bound := new(big.Int).Sub(n, big.NewInt(3))
+
+	for i := 0; i < bigPrimeRounds; i++ {
+		// A base between 2 and n-2.
+		base := new(big.Int).Rand(rng, bound)
+		base.Add(base, big.NewInt(2))
+
+		ok, err := millerRabin(ctx, n, base)
+
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// millerRabin runs one round of the Miller-Rabin test on an odd n above 3.
+func millerRabin(ctx context.Context, n *big.Int, base *big.Int) (bool, error) {
+	one := big.NewInt(1)
+	minusOne := new(big.Int).Sub(n, one)
+	twos := minusOne.TrailingZeroBits()
+
+	y, err := modPow(ctx, base, new(big.Int).Rsh(minusOne, twos), n)
+
+	if err != nil {
+		return false, err
+	}
+
+	if y.Cmp(one) == 0 || y.Cmp(minusOne) == 0 {
+		return true, nil
+	}
+
+	for i := uint(1); i < twos; i++ {
+		y.Mul(y, y).Mod(y, n)
+
+		if y.Cmp(minusOne) == 0 {
+			return true, nil
+		}
+
+		if y.Cmp(one) == 0 {
+			return false, nil
+		}
+	}
+
+	return false, nil
+}
+
+// nextPrime returns the smallest prime above n. Prime gaps are short, but
+// testing a large candidate is not, so ctx is checked before each one.
+func nextPrime(ctx context.Context, n *big.Int) (*big.Int, error) {
+	candidate := new(big.Int).Add(n, big.NewInt(1))
+
+	if candidate.Cmp(big.NewInt(2)) <= 0 {
+		return big.NewInt(2), nil
+	}
+
+	if candidate.Bit(0) == 0 {
+		candidate.Add(candidate, big.NewInt(1))
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		prime, err := isProbablePrime(ctx, candidate)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if prime {
+			return candidate, nil
+		}
+
+		candidate.Add(candidate, big.NewInt(2))
+	}
+}
+
+// parsePrimeCandidate parses the operand of IsPrime and NextPrime, whose
+// tests cost far more per digit than the other operations, so it is held to
+// MaxPrimeBits rather than MaxDigits alone.
+func (l bigLimits) parsePrimeCandidate(value string) (*big.Int, error) {
+	number, err := l.parseBig("number", value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bits := number.BitLen(); bits > l.MaxPrimeBits {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("number has %d bits, at most %d are allowed", bits, l.MaxPrimeBits),
+		)
+	}
+
+	return number, nil
+}
+
+// modPow computes base^exponent mod modulus for a positive modulus and a
+// non-negative exponent, checking ctx between multiplications.
+func modPow(ctx context.Context, base *big.Int, exponent *big.Int, modulus *big.Int) (*big.Int, error) {
+	if base.IsUint64() && exponent.IsUint64() && modulus.IsUint64() {
+		return new(big.Int).SetUint64(powMod(base.Uint64(), exponent.Uint64(), modulus.Uint64())), nil
+	}
+
+	base = new(big.Int).Mod(base, modulus)
+	result := new(big.Int).Mod(big.NewInt(1), modulus)
+
+	for i := exponent.BitLen() - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		result.Mul(result, result).Mod(result, modulus)
+
+		if exponent.Bit(i) == 1 {
+			result.Mul(result, base).Mod(result, modulus)
+		}
+	}
+
+	return result, nil
+}
+
+// modInverse returns the inverse of a modulo the positive modulus, or nil if a
+// and modulus are not coprime.
+func modInverse(a *big.Int, modulus *big.Int) *big.Int {
+	if modulus.Cmp(big.NewInt(1)) == 0 {
+		return big.NewInt(0)
+	}
+
+	return new(big.Int).ModInverse(new(big.Int).Mod(a, modulus), modulus)
+}
+
+func (l bigLimits) parseModulus(value string) (*big.Int, error) {
+	modulus, err := l.parseBig("modulus", value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if modulus.Sign() <= 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received a non-positive modulus: %v", modulus),
+		)
+	}
+
+	return modulus, nil
+}
+
+func noInverseStatus(a *big.Int, modulus *big.Int) error {
+	return status.Errorf(
+		codes.InvalidArgument,
+		fmt.Sprintf("%v has no inverse modulo %v, they share the factor %v", a, modulus, new(big.Int).GCD(nil, nil, a, modulus)),
+	)
+}
+
+func (s *server) IsPrime(ctx context.Context, req *calculatorpb.IsPrimeRequest) (*calculatorpb.IsPrimeResponse, error) {
+	fmt.Printf("IsPrime function was invoked with %v\n", req)
+
+	number, err := s.big.parsePrimeCandidate(req.GetNumber())
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.big.Timeout)
+
+	defer cancel()
+
+	prime, err := isProbablePrime(ctx, number)
+
+	if err != nil {
+		return nil, s.big.contextStatus(err)
+	}
+
+	res := &calculatorpb.IsPrimeResponse{
+		IsPrime: prime,
+	}
+
+	return res, nil
+}
+
+func (s *server) NextPrime(ctx context.Context, req *calculatorpb.NextPrimeRequest) (*calculatorpb.NextPrimeResponse, error) {
+	fmt.Printf("NextPrime function was invoked with %v\n", req)
+
+	number, err := s.big.parsePrimeCandidate(req.GetNumber())
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.big.Timeout)
+
+	defer cancel()
+
+	prime, err := nextPrime(ctx, number)
+
+	if err != nil {
+		return nil, s.big.contextStatus(err)
+	}
+
+	res := &calculatorpb.NextPrimeResponse{
+		Prime: prime.String(),
+	}
+
+	return res, nil
+}
+
+func (s *server) Gcd(ctx context.Context, req *calculatorpb.GcdRequest) (*calculatorpb.GcdResponse, error) {
+	fmt.Printf("Gcd function was invoked with %v\n", req)
+
+	a, b, err := s.big.parseBigPair("a", req.GetA(), "b", req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.GcdResponse{
+		Result: new(big.Int).GCD(nil, nil, a, b).String(),
+	}
+
+	return res, nil
+}
+
+func (s *server) Lcm(ctx context.Context, req *calculatorpb.LcmRequest) (*calculatorpb.LcmResponse, error) {
+	fmt.Printf("Lcm function was invoked with %v\n", req)
+
+	a, b, err := s.big.parseBigPair("a", req.GetA(), "b", req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	lcm := new(big.Int)
+
+	if a.Sign() != 0 && b.Sign() != 0 {
+		lcm.Quo(a, new(big.Int).GCD(nil, nil, a, b))
+		lcm.Mul(lcm, b).Abs(lcm)
+	}
+
+	res := &calculatorpb.LcmResponse{
+		Result: lcm.String(),
+	}
+
+	return res, nil
+}
+
+func (s *server) ModPow(ctx context.Context, req *calculatorpb.ModPowRequest) (*calculatorpb.ModPowResponse, error) {
+	fmt.Printf("ModPow function was invoked with %v\n", req)
+
+	base, exponent, err := s.big.parseBigPair("base", req.GetBase(), "exponent", req.GetExponent())
+
+	if err != nil {
+		return nil, err
+	}
+
+	modulus, err := s.big.parseModulus(req.GetModulus())
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A negative power is a positive power of the inverse.
+	if exponent.Sign() < 0 {
+		inverse := modInverse(base, modulus)
+
+		if inverse == nil {
+			return nil, noInverseStatus(base, modulus)
+		}
+
+		base = inverse
+		exponent = new(big.Int).Neg(exponent)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.big.Timeout)
+
+	defer cancel()
+
+	result, err := modPow(ctx, new(big.Int).Mod(base, modulus), exponent, modulus)
+
+	if err != nil {
+		return nil, s.big.contextStatus(err)
+	}
+
+	res := &calculatorpb.ModPowResponse{
+		Result: result.String(),
+	}
+
+	return res, nil
+}
+
+func (s *server) ModInverse(ctx context.Context, req *calculatorpb.ModInverseRequest) (*calculatorpb.ModInverseResponse, error) {
+	fmt.Printf("ModInverse function was invoked with %v\n", req)
+
+	a, err := s.big.parseBig("a", req.GetA())
+
+	if err != nil {
+		return nil, err
+	}
+
+	modulus, err := s.big.parseModulus(req.GetModulus())
+
+	if err != nil {
+		return nil, err
+	}
+
+	inverse := modInverse(a, modulus)
+
+	if inverse == nil {
+		return nil, noInverseStatus(a, modulus)
+	}
+
+	res := &calculatorpb.ModInverseResponse{
+		Result: inverse.String(),
+	}
+
+	return res, nil
+}
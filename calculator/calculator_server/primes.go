@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"sort"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// millerRabinBases make Miller-Rabin deterministic for every 64-bit number.
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// trialDivisionLimit is the largest divisor tried before falling back to
+// Pollard's rho. Small factors are far cheaper to strip by division.
+const trialDivisionLimit = 1000
+
+func mulMod(a uint64, b uint64, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+
+	return bits.Rem64(hi, lo, m)
+}
+
+// addMod adds a and b, both below m, without overflowing.
+func addMod(a uint64, b uint64, m uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+
+	if carry != 0 || sum >= m {
+		sum -= m
+	}
+
+	return sum
+}
+
+func powMod(base uint64, exponent uint64, m uint64) uint64 {
+	result := uint64(1) % m
+	base %= m
+
+	for exponent > 0 {
+		if exponent&1 == 1 {
+			result = mulMod(result, base, m)
+		}
+
+		base = mulMod(base, base, m)
+		exponent >>= 1
+	}
+
+	return result
+}
+
+func gcd64(a uint64, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// isPrime64 runs a Miller-Rabin test, which is exact for 64-bit numbers with
+// millerRabinBases.
+func isPrime64(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+
+	for _, p := range millerRabinBases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+
+	d := n - 1
+	shift := bits.TrailingZeros64(d)
+	d >>= uint(shift)
+
+	for _, a := range millerRabinBases {
+		x := powMod(a, d, n)
+
+		if x == 1 || x == n-1 {
+			continue
+		}
+
+		composite := true
+
+		for i := 1; i < shift; i++ {
+			x = mulMod(x, x, n)
+
+			if x == n-1 {
+				composite = false
+				break
+			}
+		}
+
+		if composite {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pollardRho returns a non-trivial divisor of the odd composite n, using
+// Brent's cycle detection and batching the gcds of the differences.
+func pollardRho(ctx context.Context, n uint64) (uint64, error) {
+	const batch = 128
+
+	absDiff := func(a uint64, b uint64) uint64 {
+		if a > b {
+			return a - b
+		}
+
+		return b - a
+	}
+
+	for c := uint64(1); ; c++ {
+		f := func(y uint64) uint64 {
+			return addMod(mulMod(y, y, n), c, n)
+		}
+
+		y, x, ys := uint64(2), uint64(2), uint64(2)
+		q, g := uint64(1), uint64(1)
+
+		for r := 1; g == 1; r *= 2 {
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+
+			x = y
+
+			for i := 0; i < r; i++ {
+				y = f(y)
+			}
+
+			for k := 0; k < r && g == 1; k += batch {
+				ys = y
+
+				for i := 0; i < batch && i < r-k; i++ {
+					y = f(y)
+					q = mulMod(q, absDiff(x, y), n)
+				}
+
+				g = gcd64(q, n)
+			}
+		}
+
+		// The batch overshot, step through it again one difference at a
+		// time.
+		if g == n {
+			for g = 1; g == 1; {
+				ys = f(ys)
+				g = gcd64(absDiff(x, ys), n)
+			}
+		}
+
+		// A cycle without a factor, retry with another polynomial.
+		if g != n {
+			return g, nil
+		}
+	}
+}
+
+// factorize returns the prime factors of n in ascending order, with
+// multiplicity. It stops with the context's error once ctx is done.
+func factorize(ctx context.Context, n uint64) ([]uint64, error) {
+	factors := []uint64{}
+
+	for n%2 == 0 && n > 1 {
+		factors = append(factors, 2)
+		n /= 2
+	}
+
+	for d := uint64(3); d <= trialDivisionLimit && d*d <= n; d += 2 {
+		for n%d == 0 {
+			factors = append(factors, d)
+			n /= d
+		}
+	}
+
+	pending := []uint64{}
+
+	if n > 1 {
+		pending = append(pending, n)
+	}
+
+	for len(pending) > 0 {
+		m := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if isPrime64(m) {
+			factors = append(factors, m)
+			continue
+		}
+
+		d, err := pollardRho(ctx, m)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pending = append(pending, d, m/d)
+	}
+
+	sort.Slice(factors, func(i, j int) bool { return factors[i] < factors[j] })
+
+	return factors, nil
+}
+
+func (*server) Factorize(req *calculatorpb.FactorizeRequest, stream calculatorpb.CalculatorService_FactorizeServer) error {
+	fmt.Printf("Factorize function was invoked with %v\n", req)
+
+	number := req.GetNumber()
+
+	if number == 0 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Cannot factorize zero",
+		)
+	}
+
+	factors, err := factorize(stream.Context(), number)
+
+	if err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	for _, factor := range factors {
+		res := &calculatorpb.FactorizeResponse{
+			PrimeFactor: factor,
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
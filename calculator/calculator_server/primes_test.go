@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func repeated(factor uint64, times int) []uint64 {
+	factors := make([]uint64, times)
+
+	for i := range factors {
+		factors[i] = factor
+	}
+
+	return factors
+}
+
+func TestFactorize(t *testing.T) {
+	tests := []struct {
+		name string
+		n    uint64
+		want []uint64
+	}{
+		{name: "one", n: 1, want: []uint64{}},
+		{name: "two", n: 2, want: []uint64{2}},
+		{name: "power of two", n: 1 << 63, want: repeated(2, 63)},
+		{name: "power of three", n: 12157665459056928801, want: repeated(3, 40)},
+		{name: "largest 64-bit prime", n: 18446744073709551557, want: []uint64{18446744073709551557}},
+		{name: "max uint64", n: 18446744073709551615, want: []uint64{3, 5, 17, 257, 641, 65537, 6700417}},
+		{name: "balanced semiprime", n: 18446743979220271189, want: []uint64{4294967279, 4294967291}},
+		{name: "unbalanced semiprime", n: 18446744073597200593, want: []uint64{1000003, 18446688733531}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := factorize(context.Background(), tt.n)
+
+			if err != nil {
+				t.Fatalf("factorize(%d): %v", tt.n, err)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("factorize(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
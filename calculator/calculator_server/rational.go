@@ -0,0 +1,355 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errExpansionTooLong is returned by decimalExpansion past its digit limit.
+var errExpansionTooLong = errors.New("decimal expansion is too long")
+
+// decimalPattern matches the decimals accepted by RationalFromDecimal,
+// capturing the sign, the integer digits, the fraction digits and the
+// repeating digits.
+var decimalPattern = regexp.MustCompile(`^([+-]?)([0-9]*)(?:\.([0-9]*)(?:\(([0-9]+)\))?)?$`)
+
+// parseRational parses a fraction, which big.Rat keeps reduced.
+func (l bigLimits) parseRational(name string, value *calculatorpb.Rational) (*big.Rat, error) {
+	if value == nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("%v is missing", name),
+		)
+	}
+
+	denominator := value.GetDenominator()
+
+	if denominator == "" {
+		denominator = "1"
+	}
+
+	num, den, err := l.parseBigPair(name+" numerator", value.GetNumerator(), name+" denominator", denominator)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if den.Sign() == 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("%v has a zero denominator", name),
+		)
+	}
+
+	return new(big.Rat).SetFrac(num, den), nil
+}
+
+func (l bigLimits) parseRationalPair(a *calculatorpb.Rational, b *calculatorpb.Rational) (*big.Rat, *big.Rat, error) {
+	x, err := l.parseRational("a", a)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	y, err := l.parseRational("b", b)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return x, y, nil
+}
+
+func rationalMessage(value *big.Rat) *calculatorpb.Rational {
+	return &calculatorpb.Rational{
+		Numerator:   value.Num().String(),
+		Denominator: value.Denom().String(),
+	}
+}
+
+// decimalExpansion writes value in base 10 with its repeating digits in
+// parentheses. The digits before the repetition are as many as the larger
+// power of 2 or 5 dividing the denominator, so the repetition ends when the
+// remainder after them comes back, without remembering every remainder.
+func decimalExpansion(ctx context.Context, value *big.Rat, maxDigits int) (string, string, error) {
+	numerator := new(big.Int).Abs(value.Num())
+	denominator := value.Denom()
+
+	integer, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+
+	var sb strings.Builder
+
+	if value.Sign() < 0 {
+		sb.WriteString("-")
+	}
+
+	sb.WriteString(integer.String())
+
+	if remainder.Sign() == 0 {
+		return sb.String(), "", nil
+	}
+
+	twos := int(denominator.TrailingZeroBits())
+	fives := 0
+	rest := new(big.Int).Rsh(denominator, uint(twos))
+	five := big.NewInt(5)
+
+	for quotient, mod := new(big.Int), new(big.Int); ; fives++ {
+		quotient.QuoRem(rest, five, mod)
+
+		if mod.Sign() != 0 {
+			break
+		}
+
+		rest.Set(quotient)
+	}
+
+	prefix := twos
+
+	if fives > prefix {
+		prefix = fives
+	}
+
+	ten := big.NewInt(10)
+	digit := new(big.Int)
+
+	digits := []byte{}
+
+	next := func() error {
+		if len(digits) >= maxDigits {
+			return errExpansionTooLong
+		}
+
+		if len(digits)%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+
+		remainder.Mul(remainder, ten)
+		digit.QuoRem(remainder, denominator, remainder)
+		digits = append(digits, byte('0'+digit.Int64()))
+
+		return nil
+	}
+
+	for i := 0; i < prefix; i++ {
+		if err := next(); err != nil {
+			return "", "", err
+		}
+	}
+
+	sb.WriteString(".")
+	sb.Write(digits)
+
+	if remainder.Sign() == 0 {
+		return sb.String(), "", nil
+	}
+
+	start := new(big.Int).Set(remainder)
+
+	for {
+		if err := next(); err != nil {
+			return "", "", err
+		}
+
+		if remainder.Cmp(start) == 0 {
+			break
+		}
+	}
+
+	repeating := string(digits[prefix:])
+
+	sb.WriteString("(")
+	sb.WriteString(repeating)
+	sb.WriteString(")")
+
+	return sb.String(), repeating, nil
+}
+
+// parseDecimal reads a decimal with optional repeating digits, using
+// 0.abc(de) = (abcde - abc) / 99900.
+func (l bigLimits) parseDecimal(decimal string) (*big.Rat, error) {
+	match := decimalPattern.FindStringSubmatch(decimal)
+
+	if match == nil || match[2]+match[3]+match[4] == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("decimal is not a base 10 number: %q", decimal),
+		)
+	}
+
+	sign, integer, fraction, repeating := match[1], match[2], match[3], match[4]
+
+	if digits := len(integer) + len(fraction) + len(repeating); digits > l.MaxDigits {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("decimal has %d digits, at most %d are allowed", digits, l.MaxDigits),
+		)
+	}
+
+	prefix, _ := new(big.Int).SetString("0"+integer+fraction, 10)
+	denominator := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fraction))), nil)
+	numerator := new(big.Int).Set(prefix)
+
+	if repeating != "" {
+		whole, _ := new(big.Int).SetString(integer+fraction+repeating, 10)
+		nines := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(repeating))), nil)
+		nines.Sub(nines, big.NewInt(1))
+
+		numerator.Sub(whole, prefix)
+		denominator.Mul(denominator, nines)
+	}
+
+	if sign == "-" {
+		numerator.Neg(numerator)
+	}
+
+	return new(big.Rat).SetFrac(numerator, denominator), nil
+}
+
+func (s *server) RationalAdd(ctx context.Context, req *calculatorpb.RationalAddRequest) (*calculatorpb.RationalAddResponse, error) {
+	fmt.Printf("RationalAdd function was invoked with %v\n", req)
+
+	a, b, err := s.big.parseRationalPair(req.GetA(), req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.RationalAddResponse{
+		Result: rationalMessage(new(big.Rat).Add(a, b)),
+	}
+
+	return res, nil
+}
+
+func (s *server) RationalSubtract(ctx context.Context, req *calculatorpb.RationalSubtractRequest) (*calculatorpb.RationalSubtractResponse, error) {
+	fmt.Printf("RationalSubtract function was invoked with %v\n", req)
+
+	a, b, err := s.big.parseRationalPair(req.GetA(), req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.RationalSubtractResponse{
+		Result: rationalMessage(new(big.Rat).Sub(a, b)),
+	}
+
+	return res, nil
+}
+
+func (s *server) RationalMultiply(ctx context.Context, req *calculatorpb.RationalMultiplyRequest) (*calculatorpb.RationalMultiplyResponse, error) {
+	fmt.Printf("RationalMultiply function was invoked with %v\n", req)
+
+	a, b, err := s.big.parseRationalPair(req.GetA(), req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.RationalMultiplyResponse{
+		Result: rationalMessage(new(big.Rat).Mul(a, b)),
+	}
+
+	return res, nil
+}
+
+func (s *server) RationalDivide(ctx context.Context, req *calculatorpb.RationalDivideRequest) (*calculatorpb.RationalDivideResponse, error) {
+	fmt.Printf("RationalDivide function was invoked with %v\n", req)
+
+	a, b, err := s.big.parseRationalPair(req.GetA(), req.GetB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if b.Sign() == 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot divide by zero",
+		)
+	}
+
+	res := &calculatorpb.RationalDivideResponse{
+		Result: rationalMessage(new(big.Rat).Quo(a, b)),
+	}
+
+	return res, nil
+}
+
+func (s *server) RationalSimplify(ctx context.Context, req *calculatorpb.RationalSimplifyRequest) (*calculatorpb.RationalSimplifyResponse, error) {
+	fmt.Printf("RationalSimplify function was invoked with %v\n", req)
+
+	value, err := s.big.parseRational("value", req.GetValue())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.RationalSimplifyResponse{
+		Result: rationalMessage(value),
+	}
+
+	return res, nil
+}
+
+func (s *server) RationalToDecimal(ctx context.Context, req *calculatorpb.RationalToDecimalRequest) (*calculatorpb.RationalToDecimalResponse, error) {
+	fmt.Printf("RationalToDecimal function was invoked with %v\n", req)
+
+	value, err := s.big.parseRational("value", req.GetValue())
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.big.Timeout)
+
+	defer cancel()
+
+	decimal, repeating, err := decimalExpansion(ctx, value, s.big.MaxResultDigits)
+
+	if err == errExpansionTooLong {
+		return nil, status.Errorf(
+			codes.OutOfRange,
+			fmt.Sprintf("Expansion has more than %d digits after the point", s.big.MaxResultDigits),
+		)
+	}
+
+	if err != nil {
+		return nil, s.big.contextStatus(err)
+	}
+
+	res := &calculatorpb.RationalToDecimalResponse{
+		Decimal:   decimal,
+		Repeating: repeating,
+	}
+
+	return res, nil
+}
+
+func (s *server) RationalFromDecimal(ctx context.Context, req *calculatorpb.RationalFromDecimalRequest) (*calculatorpb.RationalFromDecimalResponse, error) {
+	fmt.Printf("RationalFromDecimal function was invoked with %v\n", req)
+
+	value, err := s.big.parseDecimal(req.GetDecimal())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &calculatorpb.RationalFromDecimalResponse{
+		Result: rationalMessage(value),
+	}
+
+	return res, nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"testing"
+)
+
+func TestDecimalExpansionRoundTrip(t *testing.T) {
+	limits := bigLimits{MaxDigits: 1000}
+
+	tests := []struct {
+		value     string
+		decimal   string
+		repeating string
+	}{
+		{value: "0", decimal: "0"},
+		{value: "5", decimal: "5"},
+		{value: "-7/4", decimal: "-1.75"},
+		{value: "1/3", decimal: "0.(3)", repeating: "3"},
+		{value: "-1/3", decimal: "-0.(3)", repeating: "3"},
+		{value: "1/6", decimal: "0.1(6)", repeating: "6"},
+		{value: "1/12", decimal: "0.08(3)", repeating: "3"},
+		{value: "22/7", decimal: "3.(142857)", repeating: "142857"},
+		{value: "1/81", decimal: "0.(012345679)", repeating: "012345679"},
+		{value: "7/1250", decimal: "0.0056"},
+		{value: "1/99900", decimal: "0.00(001)", repeating: "001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			value, ok := new(big.Rat).SetString(tt.value)
+
+			if !ok {
+				t.Fatalf("cannot parse %v", tt.value)
+			}
+
+			decimal, repeating, err := decimalExpansion(context.Background(), value, 100)
+
+			if err != nil {
+				t.Fatalf("decimalExpansion(%v): %v", tt.value, err)
+			}
+
+			if decimal != tt.decimal || repeating != tt.repeating {
+				t.Errorf("decimalExpansion(%v) = %q, %q, want %q, %q", tt.value, decimal, repeating, tt.decimal, tt.repeating)
+			}
+
+			parsed, err := limits.parseDecimal(decimal)
+
+			if err != nil {
+				t.Fatalf("parseDecimal(%q): %v", decimal, err)
+			}
+
+			if parsed.Cmp(value) != 0 {
+				t.Errorf("parseDecimal(%q) = %v, want %v", decimal, parsed, value)
+			}
+		})
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	limits := bigLimits{MaxDigits: 10}
+
+	tests := []struct {
+		decimal string
+		want    string
+	}{
+		{decimal: "12", want: "12"},
+		{decimal: "+.5", want: "1/2"},
+		{decimal: "-2.", want: "-2"},
+		{decimal: "0.(9)", want: "1"},
+		{decimal: "1.2(34)", want: "611/495"},
+		{decimal: ".(142857)", want: "1/7"},
+		{decimal: ""},
+		{decimal: "."},
+		{decimal: "1.(3"},
+		{decimal: "1e5"},
+		{decimal: "12345678901"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.decimal, func(t *testing.T) {
+			got, err := limits.parseDecimal(tt.decimal)
+
+			if tt.want == "" {
+				if err == nil {
+					t.Errorf("parseDecimal(%q) = %v, want an error", tt.decimal, got)
+				}
+
+				return
+			}
+
+			want, _ := new(big.Rat).SetString(tt.want)
+
+			if err != nil || got.Cmp(want) != 0 {
+				t.Errorf("parseDecimal(%q) = %v, %v, want %v", tt.decimal, got, err, want)
+			}
+		})
+	}
+}
+
+func TestDecimalExpansionTooLong(t *testing.T) {
+	_, _, err := decimalExpansion(context.Background(), big.NewRat(1, 97), 10)
+
+	if err != errExpansionTooLong {
+		t.Errorf("got %v, want errExpansionTooLong", err)
+	}
+}
@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"io"
 	"log"
 	"math"
 	"net"
+	"time"
 
 	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
 
@@ -18,6 +20,11 @@ import (
 
 type server struct {
 	calculatorpb.CalculatorServiceServer
+
+	big     bigLimits
+	session sessionLimits
+	primes  primeLimits
+	window  windowLimits
 }
 
 func (*server) Sum(ctx context.Context, req *calculatorpb.SumRequest) (*calculatorpb.SumResponse, error) {
@@ -39,18 +46,28 @@ func (*server) Sum(ctx context.Context, req *calculatorpb.SumRequest) (*calculat
 func (*server) PrimeNumberDecomposition(req *calculatorpb.PrimeNumberDecompositionRequest, stream calculatorpb.CalculatorService_PrimeNumberDecompositionServer) error {
 	fmt.Printf("PrimeNumberDecomposition function was invoked with %v\n", req)
 
-	divisor := int32(2)
 	num := req.GetNumber()
 
-	for num > 1 {
-		if num%divisor == 0 {
-			num = num / divisor
-			res := &calculatorpb.PrimeNumberDecompositionResponse{
-				PrimeFactor: divisor,
-			}
-			stream.Send(res)
-		} else {
-			divisor++
+	if num <= 0 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received a non-positive number: %v", num),
+		)
+	}
+
+	factors, err := factorize(stream.Context(), uint64(num))
+
+	if err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	for _, factor := range factors {
+		res := &calculatorpb.PrimeNumberDecompositionResponse{
+			PrimeFactor: int32(factor),
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
 		}
 	}
 
@@ -97,7 +114,8 @@ func (*server) FindMaximum(stream calculatorpb.CalculatorService_FindMaximumServ
 
 	fmt.Printf("ComputeAverage function was invoked with a streaming request\n")
 
-	max := int32(0)
+	var max int32
+	received := false
 
 	for {
 		msg, err := stream.Recv()
@@ -113,8 +131,9 @@ func (*server) FindMaximum(stream calculatorpb.CalculatorService_FindMaximumServ
 
 		number := msg.GetNumber()
 
-		if number > max {
+		if !received || number > max {
 			max = number
+			received = true
 
 			res := &calculatorpb.FindMaximumResponse{
 				Maximum: max,
@@ -136,15 +155,24 @@ func (*server) SquareRoot(ctx context.Context, req *calculatorpb.SquareRootReque
 
 	number := req.GetNumber()
 
-	if number < 0 {
+	if number < 0 && !req.GetAllowComplex() {
 		return nil, status.Errorf(
 			codes.InvalidArgument,
 			fmt.Sprintf("Received a negative number: %v\n", number),
 		)
 	}
 
-	res := &calculatorpb.SquareRootResponse{
-		SquareRoot: math.Sqrt(float64(number)),
+	res := &calculatorpb.SquareRootResponse{}
+
+	if number >= 0 {
+		res.SquareRoot = math.Sqrt(float64(number))
+	}
+
+	if req.GetAllowComplex() {
+		res.ComplexRoot = &calculatorpb.Complex{
+			Real:      res.SquareRoot,
+			Imaginary: math.Sqrt(math.Max(0, -float64(number))),
+		}
 	}
 
 	return res, nil
@@ -153,6 +181,59 @@ func (*server) SquareRoot(ctx context.Context, req *calculatorpb.SquareRootReque
 
 func main() {
 
+	bigMaxDigits := flag.Int("big-max-digits", 10000, "Maximum number of digits of a big number operand")
+	bigMaxResultDigits := flag.Int("big-max-result-digits", 100000, "Maximum number of digits of a BigPower result")
+	bigMaxPrimeBits := flag.Int("big-max-prime-bits", 2048, "Maximum number of bits of an IsPrime or NextPrime operand")
+	bigTimeout := flag.Duration("big-timeout", 5*time.Second, "Maximum duration of a big number computation")
+	sessionMaxNames := flag.Int("session-max-names", 100, "Maximum number of variables and functions in a Session")
+	sessionTimeout := flag.Duration("session-timeout", time.Second, "Maximum evaluation time of a Session statement")
+	primesMaxRange := flag.Uint64("primes-max-range", 1000000000, "Maximum number of integers in a StreamPrimes or CountPrimes range")
+	primesBatchSize := flag.Int("primes-batch-size", 1000, "Number of primes per StreamPrimes message")
+	windowMaxSize := flag.Int("window-max-size", 100000, "Maximum number of numbers in a WindowedExtremes window")
+	windowMaxDuration := flag.Duration("window-max-duration", time.Hour, "Maximum duration of a WindowedExtremes time window")
+
+	flag.Parse()
+
+	if *bigMaxDigits < 1 {
+		log.Fatalf("big-max-digits must be positive")
+	}
+
+	if *bigMaxResultDigits < 1 {
+		log.Fatalf("big-max-result-digits must be positive")
+	}
+
+	if *bigMaxPrimeBits < 1 {
+		log.Fatalf("big-max-prime-bits must be positive")
+	}
+
+	if *bigTimeout <= 0 {
+		log.Fatalf("big-timeout must be positive")
+	}
+
+	if *sessionMaxNames < 1 {
+		log.Fatalf("session-max-names must be positive")
+	}
+
+	if *sessionTimeout <= 0 {
+		log.Fatalf("session-timeout must be positive")
+	}
+
+	if *primesMaxRange < 1 {
+		log.Fatalf("primes-max-range must be positive")
+	}
+
+	if *primesBatchSize < 1 {
+		log.Fatalf("primes-batch-size must be positive")
+	}
+
+	if *windowMaxSize < 1 {
+		log.Fatalf("window-max-size must be positive")
+	}
+
+	if *windowMaxDuration <= 0 {
+		log.Fatalf("window-max-duration must be positive")
+	}
+
 	fmt.Println("Calculator Server")
 
 	lis, err := net.Listen("tcp", ":50051")
@@ -163,7 +244,26 @@ func main() {
 
 	s := grpc.NewServer()
 
-	calculatorpb.RegisterCalculatorServiceServer(s, &server{})
+	calculatorpb.RegisterCalculatorServiceServer(s, &server{
+		big: bigLimits{
+			MaxDigits:       *bigMaxDigits,
+			MaxResultDigits: *bigMaxResultDigits,
+			MaxPrimeBits:    *bigMaxPrimeBits,
+			Timeout:         *bigTimeout,
+		},
+		session: sessionLimits{
+			MaxNames: *sessionMaxNames,
+			Timeout:  *sessionTimeout,
+		},
+		primes: primeLimits{
+			MaxRange:  *primesMaxRange,
+			BatchSize: *primesBatchSize,
+		},
+		window: windowLimits{
+			MaxSize:     *windowMaxSize,
+			MaxDuration: *windowMaxDuration,
+		},
+	})
 
 	reflection.Register(s)
 
@@ -0,0 +1,404 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ansVariable holds the value of the last successful expression or
+// assignment of a session.
+const ansVariable = "ans"
+
+// sessionLimits bounds the state and work of a calculator session.
+type sessionLimits struct {
+	// MaxNames is how many variables and functions a session may define.
+	MaxNames int
+	// Timeout bounds the evaluation of a single statement.
+	Timeout time.Duration
+}
+
+// userFunction is a function defined in a session.
+type userFunction struct {
+	params []string
+	body   node
+}
+
+// statement is a parsed session statement. name is empty for plain
+// expressions.
+type statement struct {
+	name     string
+	namePos  int
+	function bool
+	params   []token
+	body     node
+}
+
+// parseStatement parses an assignment, a function definition or an
+// expression. A statement is a definition only if it starts with a name
+// followed by "=" or by a parameter list and "=", so "f(x) + 1" is still an
+// expression.
+func parseStatement(input string) (*statement, error) {
+	tokens, err := tokenize(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	stmt := &statement{}
+
+	if tokens[0].kind == tokenIdent {
+		switch tokens[1].kind {
+		case tokenAssign:
+			stmt.name = tokens[0].text
+			stmt.namePos = tokens[0].pos
+			p.next = 2
+		case tokenLeftParen:
+			if params, next, ok := parseParams(tokens, 2); ok {
+				stmt.name = tokens[0].text
+				stmt.namePos = tokens[0].pos
+				stmt.function = true
+				stmt.params = params
+				p.next = next
+			}
+		}
+	}
+
+	body, err := p.parseAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	stmt.body = body
+
+	return stmt, nil
+}
+
+// parseParams matches a parameter list starting at tokens[i], just after the
+// opening parenthesis, followed by "=". It returns the index of the token
+// after the "=".
+func parseParams(tokens []token, i int) ([]token, int, bool) {
+	params := []token{}
+
+	if tokens[i].kind == tokenRightParen {
+		i++
+	} else {
+		for {
+			if tokens[i].kind != tokenIdent {
+				return nil, 0, false
+			}
+
+			params = append(params, tokens[i])
+			i++
+
+			if tokens[i].kind == tokenComma {
+				i++
+				continue
+			}
+
+			if tokens[i].kind != tokenRightParen {
+				return nil, 0, false
+			}
+
+			i++
+
+			break
+		}
+	}
+
+	if tokens[i].kind != tokenAssign {
+		return nil, 0, false
+	}
+
+	return params, i + 1, true
+}
+
+// calculatorSession holds the variables and functions of one Session stream.
+type calculatorSession struct {
+	limits sessionLimits
+	// variables always holds ans, which does not count towards the limit.
+	variables map[string]float64
+	functions map[string]*userFunction
+}
+
+func newCalculatorSession(limits sessionLimits) *calculatorSession {
+	return &calculatorSession{
+		limits:    limits,
+		variables: map[string]float64{ansVariable: 0},
+		functions: make(map[string]*userFunction),
+	}
+}
+
+func (s *calculatorSession) names() int {
+	return len(s.variables) - 1 + len(s.functions)
+}
+
+// execute runs a statement. Failures are returned as expressionErrors and
+// leave the session unchanged.
+func (s *calculatorSession) execute(ctx context.Context, input string) (*calculatorpb.SessionResponse, error) {
+	stmt, err := parseStatement(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if stmt.function {
+		if err := s.define(stmt); err != nil {
+			return nil, err
+		}
+
+		return &calculatorpb.SessionResponse{Defined: stmt.name}, nil
+	}
+
+	if stmt.name != "" {
+		if err := s.checkVariableName(stmt); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.limits.Timeout)
+
+	defer cancel()
+
+	e := &evaluator{
+		variables: s.variables,
+		functions: s.functions,
+		ctx:       ctx,
+	}
+
+	result, err := e.eval(stmt.body)
+
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return nil, errorAt(0, "statement took longer than %v", s.limits.Timeout)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if stmt.name != "" {
+		s.variables[stmt.name] = result
+	}
+
+	s.variables[ansVariable] = result
+
+	res := &calculatorpb.SessionResponse{
+		Result:  result,
+		Defined: stmt.name,
+	}
+
+	return res, nil
+}
+
+func isConstant(name string) bool {
+	_, ok := constants[name]
+	return ok
+}
+
+func isBuiltin(name string) bool {
+	_, ok := builtins[name]
+	return ok
+}
+
+func (s *calculatorSession) checkVariableName(stmt *statement) error {
+	switch {
+	case stmt.name == ansVariable:
+		return errorAt(stmt.namePos, "%s cannot be assigned", ansVariable)
+	case isConstant(stmt.name):
+		return errorAt(stmt.namePos, "%s is a constant", stmt.name)
+	case isBuiltin(stmt.name) || s.functions[stmt.name] != nil:
+		return errorAt(stmt.namePos, "%s is a function", stmt.name)
+	}
+
+	if _, ok := s.variables[stmt.name]; !ok && s.names() >= s.limits.MaxNames {
+		return errorAt(stmt.namePos, "a session cannot define more than %d variables and functions", s.limits.MaxNames)
+	}
+
+	return nil
+}
+
+// define adds or replaces a user function. The body is checked up front so
+// that mistakes are reported at their position in the definition, and so that
+// functions cannot call themselves, directly or through other functions.
+func (s *calculatorSession) define(stmt *statement) error {
+	switch {
+	case stmt.name == ansVariable:
+		return errorAt(stmt.namePos, "%s is a variable", ansVariable)
+	case isBuiltin(stmt.name):
+		return errorAt(stmt.namePos, "%s is a built-in function", stmt.name)
+	case isConstant(stmt.name):
+		return errorAt(stmt.namePos, "%s is a constant", stmt.name)
+	}
+
+	if _, ok := s.variables[stmt.name]; ok {
+		return errorAt(stmt.namePos, "%s is a variable", stmt.name)
+	}
+
+	if _, ok := s.functions[stmt.name]; !ok && s.names() >= s.limits.MaxNames {
+		return errorAt(stmt.namePos, "a session cannot define more than %d variables and functions", s.limits.MaxNames)
+	}
+
+	params := make(map[string]bool, len(stmt.params))
+	names := make([]string, len(stmt.params))
+
+	for i, param := range stmt.params {
+		if params[param.text] {
+			return errorAt(param.pos, "duplicate parameter %q", param.text)
+		}
+
+		params[param.text] = true
+		names[i] = param.text
+	}
+
+	if err := s.check(stmt.body, stmt.name, params); err != nil {
+		return err
+	}
+
+	s.functions[stmt.name] = &userFunction{params: names, body: stmt.body}
+
+	return nil
+}
+
+// check validates the body of a definition of the function name.
+func (s *calculatorSession) check(n node, name string, params map[string]bool) error {
+	switch n := n.(type) {
+	case *identNode:
+		if params[n.name] {
+			return nil
+		}
+
+		if _, ok := s.variables[n.name]; ok {
+			return nil
+		}
+
+		if isConstant(n.name) {
+			return nil
+		}
+
+		return errorAt(n.pos, "unknown variable %q", n.name)
+	case *unaryNode:
+		return s.check(n.operand, name, params)
+	case *binaryNode:
+		if err := s.check(n.left, name, params); err != nil {
+			return err
+		}
+
+		return s.check(n.right, name, params)
+	case *callNode:
+		for _, arg := range n.args {
+			if err := s.check(arg, name, params); err != nil {
+				return err
+			}
+		}
+
+		if n.name == name {
+			return errorAt(n.pos, "%s cannot call itself", name)
+		}
+
+		if fn, ok := s.functions[n.name]; ok {
+			if len(n.args) != len(fn.params) {
+				return errorAt(n.pos, "%s takes %s, got %d", n.name, describeArity(len(fn.params), len(fn.params)), len(n.args))
+			}
+
+			if s.calls(fn.body, name, map[string]bool{}) {
+				return errorAt(n.pos, "%s would call itself through %s", name, n.name)
+			}
+
+			return nil
+		}
+
+		if fn, ok := builtins[n.name]; ok {
+			if len(n.args) < fn.minArgs || (fn.maxArgs >= 0 && len(n.args) > fn.maxArgs) {
+				return errorAt(n.pos, "%s takes %s, got %d", n.name, describeArity(fn.minArgs, fn.maxArgs), len(n.args))
+			}
+
+			return nil
+		}
+
+		return errorAt(n.pos, "unknown function %q", n.name)
+	}
+
+	return nil
+}
+
+// calls reports whether evaluating n can call the function target.
+func (s *calculatorSession) calls(n node, target string, visited map[string]bool) bool {
+	switch n := n.(type) {
+	case *unaryNode:
+		return s.calls(n.operand, target, visited)
+	case *binaryNode:
+		return s.calls(n.left, target, visited) || s.calls(n.right, target, visited)
+	case *callNode:
+		for _, arg := range n.args {
+			if s.calls(arg, target, visited) {
+				return true
+			}
+		}
+
+		if n.name == target {
+			return true
+		}
+
+		fn, ok := s.functions[n.name]
+
+		if !ok || visited[n.name] {
+			return false
+		}
+
+		visited[n.name] = true
+
+		return s.calls(fn.body, target, visited)
+	}
+
+	return false
+}
+
+func (s *server) Session(stream calculatorpb.CalculatorService_SessionServer) error {
+	fmt.Printf("Session function was invoked with a streaming request\n")
+
+	session := newCalculatorSession(s.session)
+
+	for {
+		req, err := stream.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		res, err := session.execute(stream.Context(), req.GetStatement())
+
+		if err != nil {
+			exprErr, ok := err.(*expressionError)
+
+			if !ok {
+				return status.Errorf(
+					codes.Internal,
+					fmt.Sprintf("Internal error: %v", err),
+				)
+			}
+
+			res = &calculatorpb.SessionResponse{
+				Error: &calculatorpb.ExpressionError{
+					Position: int32(exprErr.pos + 1),
+					Message:  exprErr.msg,
+				},
+			}
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
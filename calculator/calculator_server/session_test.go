@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSessionRejectsRecursion(t *testing.T) {
+	tests := []struct {
+		name     string
+		prelude  []string
+		input    string
+		pos      int
+		msg      string
+		evaluate string
+		want     float64
+	}{
+		{name: "direct", input: "f(x) = f(x - 1)", pos: 7, msg: "f cannot call itself"},
+		{name: "direct in an argument", input: "f(x) = max(1, f(x))", pos: 14, msg: "f cannot call itself"},
+		{
+			name:    "through another function",
+			prelude: []string{"g(x) = x", "f(x) = g(x)"},
+			input:   "g(x) = f(x)",
+			pos:     7,
+			msg:     "g would call itself through f",
+		},
+		{
+			name:    "through a chain",
+			prelude: []string{"a(x) = x", "b(x) = a(x)", "c(x) = b(x) + 1"},
+			input:   "a(x) = 2 * c(x)",
+			pos:     11,
+			msg:     "a would call itself through c",
+		},
+		{
+			name:    "through an argument of another function",
+			prelude: []string{"h(x) = x", "f(x) = max(0, h(x))"},
+			input:   "h(x) = f(x)",
+			pos:     7,
+			msg:     "h would call itself through f",
+		},
+		{
+			name:     "shared callees",
+			prelude:  []string{"a(x) = x + 1", "b(x) = a(x) + a(x)", "c(x) = b(x) + a(x)"},
+			input:    "d(x) = c(x) + b(x)",
+			evaluate: "d(1)",
+			want:     10,
+		},
+		{
+			name:     "redefined callee",
+			prelude:  []string{"g(x) = x", "f(x) = g(x) + 1"},
+			input:    "g(x) = 2 * x",
+			evaluate: "f(3)",
+			want:     7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newCalculatorSession(sessionLimits{MaxNames: 10, Timeout: time.Second})
+
+			for _, input := range tt.prelude {
+				if _, err := s.execute(ctx, input); err != nil {
+					t.Fatalf("execute(%q): %v", input, err)
+				}
+			}
+
+			_, err := s.execute(ctx, tt.input)
+
+			if tt.msg == "" {
+				if err != nil {
+					t.Fatalf("execute(%q): %v", tt.input, err)
+				}
+
+				res, err := s.execute(ctx, tt.evaluate)
+
+				if err != nil || res.GetResult() != tt.want {
+					t.Errorf("execute(%q): got %v, %v, want %v", tt.evaluate, res.GetResult(), err, tt.want)
+				}
+
+				return
+			}
+
+			exprErr, ok := err.(*expressionError)
+
+			if !ok {
+				t.Fatalf("execute(%q): got %v, want an expressionError", tt.input, err)
+			}
+
+			if exprErr.pos != tt.pos || !strings.Contains(exprErr.msg, tt.msg) {
+				t.Errorf("execute(%q): got %q at %d, want %q at %d", tt.input, exprErr.msg, exprErr.pos, tt.msg, tt.pos)
+			}
+		})
+	}
+}
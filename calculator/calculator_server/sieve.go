@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// primeLimits bounds the work of StreamPrimes and CountPrimes.
+type primeLimits struct {
+	// MaxRange is the largest number of integers a range may span.
+	MaxRange uint64
+	// BatchSize is how many primes StreamPrimes sends per message.
+	BatchSize int
+}
+
+const (
+	// sieveBaseLimit bounds the primes used to cross off composites. Numbers
+	// below its square are settled by the sieve alone, larger survivors are
+	// checked with Miller-Rabin, so memory stays bounded for any 64-bit
+	// range.
+	sieveBaseLimit = 1 << 20
+	// sieveSegmentSize is how many odd numbers are sieved at once.
+	sieveSegmentSize = 1 << 18
+)
+
+// sieveBasePrimes are the odd primes below sieveBaseLimit.
+var sieveBasePrimes = oddPrimesBelow(sieveBaseLimit)
+
+// oddPrimesBelow runs a plain sieve of Eratosthenes.
+func oddPrimesBelow(limit int) []uint64 {
+	composite := make([]bool, limit)
+	primes := []uint64{}
+
+	for i := 3; i < limit; i += 2 {
+		if composite[i] {
+			continue
+		}
+
+		primes = append(primes, uint64(i))
+
+		for j := i * i; j < limit; j += 2 * i {
+			composite[j] = true
+		}
+	}
+
+	return primes
+}
+
+// sievePrimes calls emit with every prime in [from, to] in ascending order. It
+// sieves one segment of odd numbers at a time and returns the context's error
+// if ctx is done between segments.
+func sievePrimes(ctx context.Context, from uint64, to uint64, emit func(prime uint64) error) error {
+	if from <= 2 && to >= 2 {
+		if err := emit(2); err != nil {
+			return err
+		}
+	}
+
+	if from < 3 {
+		from = 3
+	}
+
+	if from%2 == 0 {
+		from++
+	}
+
+	if from > to {
+		return nil
+	}
+
+	composite := make([]bool, sieveSegmentSize)
+
+	for low := from; ; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// The segment holds low, low+2, ... up to high.
+		size := uint64(sieveSegmentSize)
+
+		if (to-low)/2+1 < size {
+			size = (to-low)/2 + 1
+		}
+
+		high := low + 2*(size-1)
+
+		for i := range composite[:size] {
+			composite[i] = false
+		}
+
+		for _, p := range sieveBasePrimes {
+			if p*p > high {
+				break
+			}
+
+			// First odd multiple of p in the segment, at least p*p so that p
+			// itself is kept.
+			first := p * p
+
+			if first < low {
+				offset := (p - low%p) % p
+
+				if offset > high-low {
+					continue
+				}
+
+				first = low + offset
+
+				if first%2 == 0 {
+					if p > high-first {
+						continue
+					}
+
+					first += p
+				}
+			}
+
+			for i := (first - low) / 2; i < size; i += p {
+				composite[i] = true
+			}
+		}
+
+		for i := uint64(0); i < size; i++ {
+			if composite[i] {
+				continue
+			}
+
+			n := low + 2*i
+
+			if n >= sieveBaseLimit*sieveBaseLimit && !isPrime64(n) {
+				continue
+			}
+
+			if err := emit(n); err != nil {
+				return err
+			}
+		}
+
+		if high >= to-1 {
+			return nil
+		}
+
+		low = high + 2
+	}
+}
+
+// checkPrimeRange validates a range against the server's limits.
+func (l primeLimits) checkPrimeRange(from uint64, to uint64) error {
+	if from > to {
+		return status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received an empty range: from %v is above to %v", from, to),
+		)
+	}
+
+	if to-from >= l.MaxRange {
+		return status.Errorf(
+			codes.OutOfRange,
+			fmt.Sprintf("Range spans %v numbers, at most %v are allowed", to-from+1, l.MaxRange),
+		)
+	}
+
+	return nil
+}
+
+func (s *server) StreamPrimes(req *calculatorpb.StreamPrimesRequest, stream calculatorpb.CalculatorService_StreamPrimesServer) error {
+	fmt.Printf("StreamPrimes function was invoked with %v\n", req)
+
+	if err := s.primes.checkPrimeRange(req.GetFrom(), req.GetTo()); err != nil {
+		return err
+	}
+
+	batch := make([]uint64, 0, s.primes.BatchSize)
+
+	send := func() error {
+		res := &calculatorpb.StreamPrimesResponse{
+			Primes: batch,
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+
+		batch = make([]uint64, 0, s.primes.BatchSize)
+
+		return nil
+	}
+
+	err := sievePrimes(stream.Context(), req.GetFrom(), req.GetTo(), func(prime uint64) error {
+		batch = append(batch, prime)
+
+		if len(batch) < s.primes.BatchSize {
+			return nil
+		}
+
+		return send()
+	})
+
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return status.FromContextError(err).Err()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		return send()
+	}
+
+	return nil
+}
+
+func (s *server) CountPrimes(ctx context.Context, req *calculatorpb.CountPrimesRequest) (*calculatorpb.CountPrimesResponse, error) {
+	fmt.Printf("CountPrimes function was invoked with %v\n", req)
+
+	if err := s.primes.checkPrimeRange(req.GetFrom(), req.GetTo()); err != nil {
+		return nil, err
+	}
+
+	count := uint64(0)
+
+	err := sievePrimes(ctx, req.GetFrom(), req.GetTo(), func(uint64) error {
+		count++
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	res := &calculatorpb.CountPrimesResponse{
+		Count: count,
+	}
+
+	return res, nil
+}
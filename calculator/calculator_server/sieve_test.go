@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestSievePrimes(t *testing.T) {
+	tests := []struct {
+		name  string
+		from  uint64
+		to    uint64
+		count int
+		first uint64
+		last  uint64
+	}{
+		{name: "small", from: 0, to: 100, count: 25, first: 2, last: 97},
+		{name: "only two", from: 2, to: 2, count: 1, first: 2, last: 2},
+		{name: "no primes", from: 24, to: 28},
+		{name: "segments from zero", from: 0, to: 1 << 20, count: 82025, first: 2, last: 1048573},
+		{name: "segments above the base primes", from: 1e12, to: 1e12 + 1<<20, count: 38056, first: 1000000000039, last: 1000001048561},
+		{name: "up to max uint64", from: math.MaxUint64 - 1000, to: math.MaxUint64, count: 21, first: 18446744073709550671, last: 18446744073709551557},
+		{name: "segments up to max uint64", from: math.MaxUint64 - 1<<20, to: math.MaxUint64, count: 23593, first: 18446744073708503083, last: 18446744073709551557},
+		{name: "only max uint64", from: math.MaxUint64, to: math.MaxUint64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count := 0
+			first, last := uint64(0), uint64(0)
+
+			err := sievePrimes(context.Background(), tt.from, tt.to, func(prime uint64) error {
+				if prime < tt.from || prime > tt.to {
+					t.Fatalf("got %d outside [%d, %d]", prime, tt.from, tt.to)
+				}
+
+				if count > 0 && prime <= last {
+					t.Fatalf("got %d after %d", prime, last)
+				}
+
+				if count == 0 {
+					first = prime
+				}
+
+				count++
+				last = prime
+
+				return nil
+			})
+
+			if err != nil {
+				t.Fatalf("sievePrimes: %v", err)
+			}
+
+			if count != tt.count || first != tt.first || last != tt.last {
+				t.Errorf("got %d primes from %d to %d, want %d from %d to %d", count, first, last, tt.count, tt.first, tt.last)
+			}
+		})
+	}
+}
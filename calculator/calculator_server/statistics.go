@@ -0,0 +1,261 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reportedPercentiles are the percentiles returned by ComputeStatistics.
+var reportedPercentiles = []float64{1, 5, 25, 50, 75, 95, 99}
+
+// exactSampleSize is how many numbers ComputeStatistics keeps to answer
+// percentiles exactly. Past it, the percentiles are estimated by sketches
+// seeded from the sample.
+const exactSampleSize = 100
+
+// exactQuantile interpolates between the closest ranks of a sorted sample.
+func exactQuantile(sorted []float64, p float64) float64 {
+	rank := p * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	return sorted[lower] + (rank-float64(lower))*(sorted[upper]-sorted[lower])
+}
+
+// quantileSketch estimates a single quantile with the P² algorithm of Jain
+// and Chlamtac, keeping five markers whatever the number of observations.
+type quantileSketch struct {
+	p float64
+	// heights are the marker values, positions their 1-based ranks and
+	// desired where the ranks should be for the markers to track p.
+	heights   [5]float64
+	positions [5]float64
+	desired   [5]float64
+	steps     [5]float64
+}
+
+// newQuantileSketch places the markers on a sorted sample of at least five
+// numbers, rather than on the first five as in the original algorithm, which
+// makes early estimates of extreme quantiles far better.
+func newQuantileSketch(p float64, sorted []float64) *quantileSketch {
+	n := float64(len(sorted))
+
+	q := &quantileSketch{
+		p:       p,
+		desired: [5]float64{1, 1 + (n-1)*p/2, 1 + (n-1)*p, 1 + (n-1)*(1+p)/2, n},
+		steps:   [5]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}
+
+	q.positions[0] = 1
+	q.positions[4] = n
+
+	// Markers need distinct ranks.
+	for i := 1; i < 4; i++ {
+		q.positions[i] = math.Max(q.positions[i-1]+1, math.Min(n-float64(4-i), math.Round(q.desired[i])))
+	}
+
+	for i, position := range q.positions {
+		q.heights[i] = sorted[int(position)-1]
+	}
+
+	return q
+}
+
+func (q *quantileSketch) Add(x float64) {
+	// Find the cell holding x, stretching the outer markers if needed.
+	var k int
+
+	switch {
+	case x < q.heights[0]:
+		q.heights[0] = x
+		k = 0
+	case x >= q.heights[4]:
+		q.heights[4] = x
+		k = 3
+	default:
+		for x >= q.heights[k+1] {
+			k++
+		}
+	}
+
+	for i := k + 1; i < 5; i++ {
+		q.positions[i]++
+	}
+
+	for i := range q.desired {
+		q.desired[i] += q.steps[i]
+	}
+
+	// Move the middle markers one rank towards their desired rank.
+	for i := 1; i < 4; i++ {
+		d := q.desired[i] - q.positions[i]
+
+		if (d >= 1 && q.positions[i+1]-q.positions[i] > 1) || (d <= -1 && q.positions[i-1]-q.positions[i] < -1) {
+			d = math.Copysign(1, d)
+
+			height := q.parabolic(i, d)
+
+			if height <= q.heights[i-1] || height >= q.heights[i+1] {
+				height = q.linear(i, d)
+			}
+
+			q.heights[i] = height
+			q.positions[i] += d
+		}
+	}
+}
+
+func (q *quantileSketch) parabolic(i int, d float64) float64 {
+	n, h := q.positions, q.heights
+
+	return h[i] + d/(n[i+1]-n[i-1])*((n[i]-n[i-1]+d)*(h[i+1]-h[i])/(n[i+1]-n[i])+(n[i+1]-n[i]-d)*(h[i]-h[i-1])/(n[i]-n[i-1]))
+}
+
+func (q *quantileSketch) linear(i int, d float64) float64 {
+	j := i + int(d)
+
+	return q.heights[i] + d*(q.heights[j]-q.heights[i])/(q.positions[j]-q.positions[i])
+}
+
+func (q *quantileSketch) Value() float64 {
+	return q.heights[2]
+}
+
+// runningStatistics accumulates the moments of a stream of numbers with
+// Welford's algorithm, which avoids the cancellation of summing squares.
+type runningStatistics struct {
+	count uint64
+	sum   float64
+	mean  float64
+	m2    float64
+	min   float64
+	max   float64
+	// sample holds the numbers until there are more than exactSampleSize,
+	// then sketches takes over.
+	sample   []float64
+	sketches []*quantileSketch
+}
+
+func (s *runningStatistics) Add(x float64) {
+	s.count++
+	s.sum += x
+
+	delta := x - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (x - s.mean)
+
+	if s.count == 1 || x < s.min {
+		s.min = x
+	}
+
+	if s.count == 1 || x > s.max {
+		s.max = x
+	}
+
+	if s.sketches == nil && len(s.sample) < exactSampleSize {
+		s.sample = append(s.sample, x)
+		return
+	}
+
+	if s.sketches == nil {
+		sort.Float64s(s.sample)
+
+		for _, p := range reportedPercentiles {
+			s.sketches = append(s.sketches, newQuantileSketch(p/100, s.sample))
+		}
+
+		s.sample = nil
+	}
+
+	for _, sketch := range s.sketches {
+		sketch.Add(x)
+	}
+}
+
+func (s *runningStatistics) Response() *calculatorpb.ComputeStatisticsResponse {
+	res := &calculatorpb.ComputeStatisticsResponse{
+		Count: s.count,
+	}
+
+	if s.count == 0 {
+		return res
+	}
+
+	res.Sum = s.sum
+	res.Mean = s.mean
+	res.Variance = s.m2 / float64(s.count)
+	res.StandardDeviation = math.Sqrt(res.Variance)
+	res.Min = s.min
+	res.Max = s.max
+
+	if s.count > 1 {
+		res.SampleVariance = s.m2 / float64(s.count-1)
+		res.SampleStandardDeviation = math.Sqrt(res.SampleVariance)
+	}
+
+	sorted := append([]float64{}, s.sample...)
+	sort.Float64s(sorted)
+
+	for i, p := range reportedPercentiles {
+		var value float64
+
+		if s.sketches == nil {
+			value = exactQuantile(sorted, p/100)
+		} else {
+			// Keep the estimates within the observed range and in order,
+			// which P² alone does not guarantee.
+			value = math.Max(s.min, math.Min(s.max, s.sketches[i].Value()))
+
+			if i > 0 && value < res.Percentiles[i-1].Value {
+				value = res.Percentiles[i-1].Value
+			}
+		}
+
+		res.Percentiles = append(res.Percentiles, &calculatorpb.Percentile{
+			Percentile: p,
+			Value:      value,
+		})
+
+		if p == 50 {
+			res.Median = value
+		}
+	}
+
+	return res
+}
+
+func (*server) ComputeStatistics(stream calculatorpb.CalculatorService_ComputeStatisticsServer) error {
+	fmt.Printf("ComputeStatistics function was invoked with a streaming request\n")
+
+	stats := &runningStatistics{}
+
+	for {
+		req, err := stream.Recv()
+
+		if err == io.EOF {
+			return stream.SendAndClose(stats.Response())
+		}
+
+		if err != nil {
+			return err
+		}
+
+		number := req.GetNumber()
+
+		if math.IsNaN(number) || math.IsInf(number, 0) {
+			return status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Received a number that is not finite: %v", number),
+			)
+		}
+
+		stats.Add(number)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestQuantileSketch(t *testing.T) {
+	const n = 20000
+
+	random := rand.New(rand.NewSource(1))
+
+	stream := func(next func(i int) float64) []float64 {
+		values := make([]float64, n)
+
+		for i := range values {
+			values[i] = next(i)
+		}
+
+		return values
+	}
+
+	tests := []struct {
+		name   string
+		values []float64
+		// tolerance is the largest error allowed, as a fraction of the
+		// spread between the 1st and 99th percentiles.
+		tolerance float64
+	}{
+		{name: "uniform", values: stream(func(int) float64 { return random.Float64() }), tolerance: 0.005},
+		{name: "normal", values: stream(func(int) float64 { return random.NormFloat64() }), tolerance: 0.01},
+		{name: "exponential", values: stream(func(int) float64 { return random.ExpFloat64() }), tolerance: 0.02},
+		{name: "ascending", values: stream(func(i int) float64 { return float64(i) }), tolerance: 0.001},
+		{name: "descending", values: stream(func(i int) float64 { return float64(n - i) }), tolerance: 0.001},
+		{name: "few distinct", values: stream(func(int) float64 { return float64(random.Intn(5)) }), tolerance: 0.01},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed := append([]float64{}, tt.values[:exactSampleSize]...)
+			sort.Float64s(seed)
+
+			sketches := make([]*quantileSketch, len(reportedPercentiles))
+
+			for i, percentile := range reportedPercentiles {
+				sketches[i] = newQuantileSketch(percentile/100, seed)
+			}
+
+			for _, x := range tt.values[exactSampleSize:] {
+				for _, sketch := range sketches {
+					sketch.Add(x)
+				}
+			}
+
+			sorted := append([]float64{}, tt.values...)
+			sort.Float64s(sorted)
+
+			spread := exactQuantile(sorted, 0.99) - exactQuantile(sorted, 0.01)
+
+			for i, sketch := range sketches {
+				for j := 1; j < 5; j++ {
+					if sketch.heights[j] < sketch.heights[j-1] || sketch.positions[j] <= sketch.positions[j-1] {
+						t.Fatalf("p%v: markers out of order, heights %v, positions %v", reportedPercentiles[i], sketch.heights, sketch.positions)
+					}
+				}
+
+				if sketch.positions[4] != n {
+					t.Errorf("p%v: last marker at %v, want %v", reportedPercentiles[i], sketch.positions[4], n)
+				}
+
+				want := exactQuantile(sorted, reportedPercentiles[i]/100)
+
+				if got := sketch.Value(); math.Abs(got-want) > tt.tolerance*spread {
+					t.Errorf("p%v = %v, want %v within %v", reportedPercentiles[i], got, want, tt.tolerance*spread)
+				}
+			}
+		})
+	}
+}
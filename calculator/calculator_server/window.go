@@ -0,0 +1,326 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxTopK bounds the top_k of a WindowedExtremes window.
+const maxTopK = 100
+
+// windowLimits bounds the windows of WindowedExtremes.
+type windowLimits struct {
+	// MaxSize is the largest count window, and the most numbers a time window
+	// holds.
+	MaxSize int
+	// MaxDuration is the longest time window.
+	MaxDuration time.Duration
+}
+
+type windowEntry struct {
+	value float64
+	// seq numbers the entries from 1 in order of arrival.
+	seq uint64
+	at  time.Time
+}
+
+// before orders entries by value, then by arrival so that none are equal.
+func (e windowEntry) before(other windowEntry) bool {
+	if e.value != other.value {
+		return e.value < other.value
+	}
+
+	return e.seq < other.seq
+}
+
+// topNode is a node of a treap holding the numbers of a window in order, so
+// that adding and removing a number takes O(log n) on average and the k
+// largest are read in O(k + log n).
+type topNode struct {
+	entry       windowEntry
+	priority    uint32
+	left, right *topNode
+}
+
+// merge joins two treaps whose entries in a all come before those in b.
+func merge(a *topNode, b *topNode) *topNode {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	if a.priority > b.priority {
+		a.right = merge(a.right, b)
+		return a
+	}
+
+	b.left = merge(a, b.left)
+
+	return b
+}
+
+// split returns the entries of t before entry and the others.
+func (t *topNode) split(entry windowEntry) (*topNode, *topNode) {
+	if t == nil {
+		return nil, nil
+	}
+
+	if t.entry.before(entry) {
+		left, right := t.right.split(entry)
+		t.right = left
+
+		return t, right
+	}
+
+	left, right := t.left.split(entry)
+	t.left = right
+
+	return left, t
+}
+
+func (t *topNode) insert(entry windowEntry) *topNode {
+	left, right := t.split(entry)
+
+	return merge(merge(left, &topNode{entry: entry, priority: rand.Uint32()}), right)
+}
+
+func (t *topNode) remove(entry windowEntry) *topNode {
+	if t == nil {
+		return nil
+	}
+
+	if t.entry.seq == entry.seq {
+		return merge(t.left, t.right)
+	}
+
+	if entry.before(t.entry) {
+		t.left = t.left.remove(entry)
+	} else {
+		t.right = t.right.remove(entry)
+	}
+
+	return t
+}
+
+// appendLargest appends the values of t in descending order until values
+// holds k.
+func (t *topNode) appendLargest(values []float64, k int) []float64 {
+	if t == nil || len(values) == k {
+		return values
+	}
+
+	values = t.right.appendLargest(values, k)
+
+	if len(values) < k {
+		values = append(values, t.entry.value)
+	}
+
+	return t.left.appendLargest(values, k)
+}
+
+// slidingWindow tracks the extremes of the last numbers of a stream. maxes
+// and mins are monotonic deques, decreasing and increasing, whose fronts are
+// the maximum and minimum.
+type slidingWindow struct {
+	size     uint64
+	duration time.Duration
+	topK     int
+
+	seq uint64
+	// first is the seq of the oldest number in the window.
+	first uint64
+	// arrivals holds the numbers of the window in order, to know when they
+	// leave it. Count windows only need it to maintain top.
+	arrivals []windowEntry
+	maxes    []windowEntry
+	mins     []windowEntry
+	top      *topNode
+}
+
+func (l windowLimits) newSlidingWindow(config *calculatorpb.WindowConfig) (*slidingWindow, error) {
+	w := &slidingWindow{
+		size:  uint64(l.MaxSize),
+		topK:  int(config.GetTopK()),
+		first: 1,
+	}
+
+	switch window := config.GetWindow().(type) {
+	case *calculatorpb.WindowConfig_Size:
+		if window.Size == 0 || window.Size > uint32(l.MaxSize) {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Window size must be between 1 and %v, received %v", l.MaxSize, window.Size),
+			)
+		}
+
+		w.size = uint64(window.Size)
+	case *calculatorpb.WindowConfig_Duration:
+		if err := window.Duration.CheckValid(); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Received an invalid window duration: %v", err),
+			)
+		}
+
+		w.duration = window.Duration.AsDuration()
+
+		if w.duration <= 0 || w.duration > l.MaxDuration {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Window duration must be positive and at most %v, received %v", l.MaxDuration, w.duration),
+			)
+		}
+	default:
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Window needs a size or a duration",
+		)
+	}
+
+	if w.topK > maxTopK {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("top_k must be at most %v, received %v", maxTopK, w.topK),
+		)
+	}
+
+	return w, nil
+}
+
+// Add appends a number received at now and drops the numbers that left the
+// window.
+func (w *slidingWindow) Add(value float64, now time.Time) {
+	w.seq++
+
+	entry := windowEntry{value: value, seq: w.seq, at: now}
+
+	if w.duration > 0 || w.topK > 0 {
+		w.arrivals = append(w.arrivals, entry)
+	}
+
+	for len(w.maxes) > 0 && w.maxes[len(w.maxes)-1].value <= value {
+		w.maxes = w.maxes[:len(w.maxes)-1]
+	}
+
+	w.maxes = append(w.maxes, entry)
+
+	for len(w.mins) > 0 && w.mins[len(w.mins)-1].value >= value {
+		w.mins = w.mins[:len(w.mins)-1]
+	}
+
+	w.mins = append(w.mins, entry)
+
+	if w.topK > 0 {
+		w.top = w.top.insert(entry)
+	}
+
+	w.evict(now)
+}
+
+func (w *slidingWindow) evict(now time.Time) {
+	if w.seq > w.size {
+		w.first = w.seq - w.size + 1
+	}
+
+	expired := now.Add(-w.duration)
+
+	// The number just added never leaves, so arrivals is not emptied.
+	for len(w.arrivals) > 0 && (w.arrivals[0].seq < w.first || (w.duration > 0 && !w.arrivals[0].at.After(expired))) {
+		if w.topK > 0 {
+			w.top = w.top.remove(w.arrivals[0])
+		}
+
+		w.arrivals = w.arrivals[1:]
+	}
+
+	if w.duration > 0 {
+		w.first = w.arrivals[0].seq
+	}
+
+	for w.maxes[0].seq < w.first {
+		w.maxes = w.maxes[1:]
+	}
+
+	for w.mins[0].seq < w.first {
+		w.mins = w.mins[1:]
+	}
+}
+
+func (w *slidingWindow) Response() *calculatorpb.WindowedExtremesResponse {
+	return &calculatorpb.WindowedExtremesResponse{
+		Max:   w.maxes[0].value,
+		Min:   w.mins[0].value,
+		Top:   w.top.appendLargest([]float64{}, w.topK),
+		Count: uint32(w.seq - w.first + 1),
+	}
+}
+
+func (s *server) WindowedExtremes(stream calculatorpb.CalculatorService_WindowedExtremesServer) error {
+	fmt.Printf("WindowedExtremes function was invoked with a streaming request\n")
+
+	var window *slidingWindow
+
+	for {
+		req, err := stream.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch r := req.GetRequest().(type) {
+		case *calculatorpb.WindowedExtremesRequest_Config:
+			if window != nil {
+				return status.Errorf(
+					codes.InvalidArgument,
+					"The window is already configured",
+				)
+			}
+
+			window, err = s.window.newSlidingWindow(r.Config)
+
+			if err != nil {
+				return err
+			}
+		case *calculatorpb.WindowedExtremesRequest_Number:
+			if window == nil {
+				return status.Errorf(
+					codes.InvalidArgument,
+					"The first message must configure the window",
+				)
+			}
+
+			if math.IsNaN(r.Number) || math.IsInf(r.Number, 0) {
+				return status.Errorf(
+					codes.InvalidArgument,
+					fmt.Sprintf("Received a number that is not finite: %v", r.Number),
+				)
+			}
+
+			window.Add(r.Number, time.Now())
+
+			if err := stream.Send(window.Response()); err != nil {
+				return err
+			}
+		default:
+			return status.Errorf(
+				codes.InvalidArgument,
+				"Received a message without a config or a number",
+			)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/newtonmunene99/grpc-go-course/calculator/calculatorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// windowSample is a number of a stream and the time it arrives at, in
+// milliseconds from the start.
+type windowSample struct {
+	value float64
+	at    int
+}
+
+// naiveExtremes computes the response of a window over samples, the last of
+// which has just arrived, by looking at every number still in the window.
+func naiveExtremes(samples []windowSample, size int, duration time.Duration, topK int) *calculatorpb.WindowedExtremesResponse {
+	last := samples[len(samples)-1]
+	inWindow := []float64{}
+
+	for i, sample := range samples {
+		if size > 0 && i < len(samples)-size {
+			continue
+		}
+
+		if duration > 0 && time.Duration(last.at-sample.at)*time.Millisecond >= duration {
+			continue
+		}
+
+		inWindow = append(inWindow, sample.value)
+	}
+
+	sorted := append([]float64{}, inWindow...)
+	sort.Sort(sort.Reverse(sort.Float64Slice(sorted)))
+
+	if topK > len(sorted) {
+		topK = len(sorted)
+	}
+
+	return &calculatorpb.WindowedExtremesResponse{
+		Max:   sorted[0],
+		Min:   sorted[len(sorted)-1],
+		Top:   sorted[:topK],
+		Count: uint32(len(sorted)),
+	}
+}
+
+func TestSlidingWindowEviction(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	randomSamples := make([]windowSample, 500)
+
+	for i := range randomSamples {
+		randomSamples[i] = windowSample{value: float64(random.Intn(20) - 10), at: i * random.Intn(3)}
+	}
+
+	for i := 1; i < len(randomSamples); i++ {
+		if randomSamples[i].at < randomSamples[i-1].at {
+			randomSamples[i].at = randomSamples[i-1].at
+		}
+	}
+
+	tests := []struct {
+		name     string
+		size     int
+		duration time.Duration
+		topK     int
+		samples  []windowSample
+	}{
+		{
+			name:    "count window of negatives",
+			size:    3,
+			topK:    2,
+			samples: []windowSample{{-5, 0}, {-1, 0}, {-7, 0}, {-3, 0}, {-9, 0}, {-2, 0}},
+		},
+		{
+			name:    "count window of repeated numbers",
+			size:    2,
+			topK:    3,
+			samples: []windowSample{{4, 0}, {4, 0}, {4, 0}, {1, 0}, {1, 0}, {4, 0}},
+		},
+		{
+			name:     "time window",
+			duration: 100 * time.Millisecond,
+			topK:     2,
+			samples:  []windowSample{{1, 0}, {5, 50}, {3, 100}, {2, 150}, {9, 400}, {0, 499}, {8, 500}},
+		},
+		{
+			name:     "time window without top",
+			duration: 10 * time.Millisecond,
+			samples:  []windowSample{{3, 0}, {2, 0}, {1, 10}, {4, 11}, {0, 30}},
+		},
+		{
+			name:    "random count window",
+			size:    17,
+			topK:    5,
+			samples: randomSamples,
+		},
+		{
+			name:     "random time window",
+			duration: 40 * time.Millisecond,
+			topK:     5,
+			samples:  randomSamples,
+		},
+	}
+
+	limits := windowLimits{MaxSize: 1000, MaxDuration: time.Hour}
+	start := time.Now()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &calculatorpb.WindowConfig{TopK: uint32(tt.topK)}
+
+			if tt.size > 0 {
+				config.Window = &calculatorpb.WindowConfig_Size{Size: uint32(tt.size)}
+			} else {
+				config.Window = &calculatorpb.WindowConfig_Duration{Duration: durationpb.New(tt.duration)}
+			}
+
+			w, err := limits.newSlidingWindow(config)
+
+			if err != nil {
+				t.Fatalf("newSlidingWindow: %v", err)
+			}
+
+			for i, sample := range tt.samples {
+				w.Add(sample.value, start.Add(time.Duration(sample.at)*time.Millisecond))
+
+				got := w.Response()
+				want := naiveExtremes(tt.samples[:i+1], tt.size, tt.duration, tt.topK)
+
+				if got.Max != want.Max || got.Min != want.Min || got.Count != want.Count || fmt.Sprint(got.Top) != fmt.Sprint(want.Top) {
+					t.Fatalf("after %d numbers: got %v, want %v", i+1, got, want)
+				}
+			}
+		})
+	}
+}
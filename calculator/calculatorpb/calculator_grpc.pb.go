@@ -20,9 +20,49 @@ const _ = grpc.SupportPackageIsVersion7
 type CalculatorServiceClient interface {
 	Sum(ctx context.Context, in *SumRequest, opts ...grpc.CallOption) (*SumResponse, error)
 	PrimeNumberDecomposition(ctx context.Context, in *PrimeNumberDecompositionRequest, opts ...grpc.CallOption) (CalculatorService_PrimeNumberDecompositionClient, error)
+	// Streams the prime factors of any 64-bit number in ascending order, each
+	// repeated as many times as it divides the number.
+	Factorize(ctx context.Context, in *FactorizeRequest, opts ...grpc.CallOption) (CalculatorService_FactorizeClient, error)
+	StreamPrimes(ctx context.Context, in *StreamPrimesRequest, opts ...grpc.CallOption) (CalculatorService_StreamPrimesClient, error)
+	CountPrimes(ctx context.Context, in *CountPrimesRequest, opts ...grpc.CallOption) (*CountPrimesResponse, error)
 	ComputeAverage(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_ComputeAverageClient, error)
+	// Summarizes the streamed numbers in constant memory.
+	ComputeStatistics(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_ComputeStatisticsClient, error)
 	FindMaximum(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_FindMaximumClient, error)
+	// Answers every number with the extremes of a sliding window ending with
+	// it.
+	WindowedExtremes(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_WindowedExtremesClient, error)
 	SquareRoot(ctx context.Context, in *SquareRootRequest, opts ...grpc.CallOption) (*SquareRootResponse, error)
+	BigAdd(ctx context.Context, in *BigAddRequest, opts ...grpc.CallOption) (*BigAddResponse, error)
+	BigSubtract(ctx context.Context, in *BigSubtractRequest, opts ...grpc.CallOption) (*BigSubtractResponse, error)
+	BigMultiply(ctx context.Context, in *BigMultiplyRequest, opts ...grpc.CallOption) (*BigMultiplyResponse, error)
+	BigDivide(ctx context.Context, in *BigDivideRequest, opts ...grpc.CallOption) (*BigDivideResponse, error)
+	BigPower(ctx context.Context, in *BigPowerRequest, opts ...grpc.CallOption) (*BigPowerResponse, error)
+	ComplexAdd(ctx context.Context, in *ComplexAddRequest, opts ...grpc.CallOption) (*ComplexAddResponse, error)
+	ComplexMultiply(ctx context.Context, in *ComplexMultiplyRequest, opts ...grpc.CallOption) (*ComplexMultiplyResponse, error)
+	ComplexDivide(ctx context.Context, in *ComplexDivideRequest, opts ...grpc.CallOption) (*ComplexDivideResponse, error)
+	ComplexMagnitude(ctx context.Context, in *ComplexMagnitudeRequest, opts ...grpc.CallOption) (*ComplexMagnitudeResponse, error)
+	ComplexPhase(ctx context.Context, in *ComplexPhaseRequest, opts ...grpc.CallOption) (*ComplexPhaseResponse, error)
+	ComplexToPolar(ctx context.Context, in *ComplexToPolarRequest, opts ...grpc.CallOption) (*ComplexToPolarResponse, error)
+	ComplexFromPolar(ctx context.Context, in *ComplexFromPolarRequest, opts ...grpc.CallOption) (*ComplexFromPolarResponse, error)
+	ComplexRoots(ctx context.Context, in *ComplexRootsRequest, opts ...grpc.CallOption) (*ComplexRootsResponse, error)
+	RationalAdd(ctx context.Context, in *RationalAddRequest, opts ...grpc.CallOption) (*RationalAddResponse, error)
+	RationalSubtract(ctx context.Context, in *RationalSubtractRequest, opts ...grpc.CallOption) (*RationalSubtractResponse, error)
+	RationalMultiply(ctx context.Context, in *RationalMultiplyRequest, opts ...grpc.CallOption) (*RationalMultiplyResponse, error)
+	RationalDivide(ctx context.Context, in *RationalDivideRequest, opts ...grpc.CallOption) (*RationalDivideResponse, error)
+	RationalSimplify(ctx context.Context, in *RationalSimplifyRequest, opts ...grpc.CallOption) (*RationalSimplifyResponse, error)
+	RationalToDecimal(ctx context.Context, in *RationalToDecimalRequest, opts ...grpc.CallOption) (*RationalToDecimalResponse, error)
+	RationalFromDecimal(ctx context.Context, in *RationalFromDecimalRequest, opts ...grpc.CallOption) (*RationalFromDecimalResponse, error)
+	IsPrime(ctx context.Context, in *IsPrimeRequest, opts ...grpc.CallOption) (*IsPrimeResponse, error)
+	NextPrime(ctx context.Context, in *NextPrimeRequest, opts ...grpc.CallOption) (*NextPrimeResponse, error)
+	Gcd(ctx context.Context, in *GcdRequest, opts ...grpc.CallOption) (*GcdResponse, error)
+	Lcm(ctx context.Context, in *LcmRequest, opts ...grpc.CallOption) (*LcmResponse, error)
+	ModPow(ctx context.Context, in *ModPowRequest, opts ...grpc.CallOption) (*ModPowResponse, error)
+	ModInverse(ctx context.Context, in *ModInverseRequest, opts ...grpc.CallOption) (*ModInverseResponse, error)
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+	// Evaluates each statement in order and answers it with one response.
+	// Variables and functions live until the stream ends.
+	Session(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_SessionClient, error)
 }
 
 type calculatorServiceClient struct {
@@ -74,8 +114,81 @@ func (x *calculatorServicePrimeNumberDecompositionClient) Recv() (*PrimeNumberDe
 	return m, nil
 }
 
+func (c *calculatorServiceClient) Factorize(ctx context.Context, in *FactorizeRequest, opts ...grpc.CallOption) (CalculatorService_FactorizeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[1], "/calculator.CalculatorService/Factorize", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceFactorizeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CalculatorService_FactorizeClient interface {
+	Recv() (*FactorizeResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceFactorizeClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceFactorizeClient) Recv() (*FactorizeResponse, error) {
+	m := new(FactorizeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorServiceClient) StreamPrimes(ctx context.Context, in *StreamPrimesRequest, opts ...grpc.CallOption) (CalculatorService_StreamPrimesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[2], "/calculator.CalculatorService/StreamPrimes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceStreamPrimesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CalculatorService_StreamPrimesClient interface {
+	Recv() (*StreamPrimesResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceStreamPrimesClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceStreamPrimesClient) Recv() (*StreamPrimesResponse, error) {
+	m := new(StreamPrimesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorServiceClient) CountPrimes(ctx context.Context, in *CountPrimesRequest, opts ...grpc.CallOption) (*CountPrimesResponse, error) {
+	out := new(CountPrimesResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/CountPrimes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *calculatorServiceClient) ComputeAverage(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_ComputeAverageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[1], "/calculator.CalculatorService/ComputeAverage", opts...)
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[3], "/calculator.CalculatorService/ComputeAverage", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,8 +221,42 @@ func (x *calculatorServiceComputeAverageClient) CloseAndRecv() (*ComputeAverageR
 	return m, nil
 }
 
+func (c *calculatorServiceClient) ComputeStatistics(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_ComputeStatisticsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[4], "/calculator.CalculatorService/ComputeStatistics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceComputeStatisticsClient{stream}
+	return x, nil
+}
+
+type CalculatorService_ComputeStatisticsClient interface {
+	Send(*ComputeStatisticsRequest) error
+	CloseAndRecv() (*ComputeStatisticsResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceComputeStatisticsClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceComputeStatisticsClient) Send(m *ComputeStatisticsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorServiceComputeStatisticsClient) CloseAndRecv() (*ComputeStatisticsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ComputeStatisticsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *calculatorServiceClient) FindMaximum(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_FindMaximumClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[2], "/calculator.CalculatorService/FindMaximum", opts...)
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[5], "/calculator.CalculatorService/FindMaximum", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -139,168 +286,1236 @@ func (x *calculatorServiceFindMaximumClient) Recv() (*FindMaximumResponse, error
 	return m, nil
 }
 
-func (c *calculatorServiceClient) SquareRoot(ctx context.Context, in *SquareRootRequest, opts ...grpc.CallOption) (*SquareRootResponse, error) {
-	out := new(SquareRootResponse)
-	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/SquareRoot", in, out, opts...)
+func (c *calculatorServiceClient) WindowedExtremes(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_WindowedExtremesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[6], "/calculator.CalculatorService/WindowedExtremes", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &calculatorServiceWindowedExtremesClient{stream}
+	return x, nil
 }
 
-// CalculatorServiceServer is the server API for CalculatorService service.
-// All implementations must embed UnimplementedCalculatorServiceServer
-// for forward compatibility
-type CalculatorServiceServer interface {
-	Sum(context.Context, *SumRequest) (*SumResponse, error)
-	PrimeNumberDecomposition(*PrimeNumberDecompositionRequest, CalculatorService_PrimeNumberDecompositionServer) error
-	ComputeAverage(CalculatorService_ComputeAverageServer) error
-	FindMaximum(CalculatorService_FindMaximumServer) error
-	SquareRoot(context.Context, *SquareRootRequest) (*SquareRootResponse, error)
-	mustEmbedUnimplementedCalculatorServiceServer()
+type CalculatorService_WindowedExtremesClient interface {
+	Send(*WindowedExtremesRequest) error
+	Recv() (*WindowedExtremesResponse, error)
+	grpc.ClientStream
 }
 
-// UnimplementedCalculatorServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedCalculatorServiceServer struct {
+type calculatorServiceWindowedExtremesClient struct {
+	grpc.ClientStream
 }
 
-func (UnimplementedCalculatorServiceServer) Sum(context.Context, *SumRequest) (*SumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sum not implemented")
-}
-func (UnimplementedCalculatorServiceServer) PrimeNumberDecomposition(*PrimeNumberDecompositionRequest, CalculatorService_PrimeNumberDecompositionServer) error {
-	return status.Errorf(codes.Unimplemented, "method PrimeNumberDecomposition not implemented")
-}
-func (UnimplementedCalculatorServiceServer) ComputeAverage(CalculatorService_ComputeAverageServer) error {
-	return status.Errorf(codes.Unimplemented, "method ComputeAverage not implemented")
-}
-func (UnimplementedCalculatorServiceServer) FindMaximum(CalculatorService_FindMaximumServer) error {
-	return status.Errorf(codes.Unimplemented, "method FindMaximum not implemented")
-}
-func (UnimplementedCalculatorServiceServer) SquareRoot(context.Context, *SquareRootRequest) (*SquareRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SquareRoot not implemented")
+func (x *calculatorServiceWindowedExtremesClient) Send(m *WindowedExtremesRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (UnimplementedCalculatorServiceServer) mustEmbedUnimplementedCalculatorServiceServer() {}
 
-// UnsafeCalculatorServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CalculatorServiceServer will
-// result in compilation errors.
-type UnsafeCalculatorServiceServer interface {
-	mustEmbedUnimplementedCalculatorServiceServer()
+func (x *calculatorServiceWindowedExtremesClient) Recv() (*WindowedExtremesResponse, error) {
+	m := new(WindowedExtremesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func RegisterCalculatorServiceServer(s grpc.ServiceRegistrar, srv CalculatorServiceServer) {
-	s.RegisterService(&CalculatorService_ServiceDesc, srv)
+func (c *calculatorServiceClient) SquareRoot(ctx context.Context, in *SquareRootRequest, opts ...grpc.CallOption) (*SquareRootResponse, error) {
+	out := new(SquareRootResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/SquareRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _CalculatorService_Sum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SumRequest)
-	if err := dec(in); err != nil {
+func (c *calculatorServiceClient) BigAdd(ctx context.Context, in *BigAddRequest, opts ...grpc.CallOption) (*BigAddResponse, error) {
+	out := new(BigAddResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/BigAdd", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CalculatorServiceServer).Sum(ctx, in)
+	return out, nil
+}
+
+func (c *calculatorServiceClient) BigSubtract(ctx context.Context, in *BigSubtractRequest, opts ...grpc.CallOption) (*BigSubtractResponse, error) {
+	out := new(BigSubtractResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/BigSubtract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/calculator.CalculatorService/Sum",
+	return out, nil
+}
+
+func (c *calculatorServiceClient) BigMultiply(ctx context.Context, in *BigMultiplyRequest, opts ...grpc.CallOption) (*BigMultiplyResponse, error) {
+	out := new(BigMultiplyResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/BigMultiply", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CalculatorServiceServer).Sum(ctx, req.(*SumRequest))
+	return out, nil
+}
+
+func (c *calculatorServiceClient) BigDivide(ctx context.Context, in *BigDivideRequest, opts ...grpc.CallOption) (*BigDivideResponse, error) {
+	out := new(BigDivideResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/BigDivide", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _CalculatorService_PrimeNumberDecomposition_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(PrimeNumberDecompositionRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *calculatorServiceClient) BigPower(ctx context.Context, in *BigPowerRequest, opts ...grpc.CallOption) (*BigPowerResponse, error) {
+	out := new(BigPowerResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/BigPower", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(CalculatorServiceServer).PrimeNumberDecomposition(m, &calculatorServicePrimeNumberDecompositionServer{stream})
+	return out, nil
 }
 
-type CalculatorService_PrimeNumberDecompositionServer interface {
-	Send(*PrimeNumberDecompositionResponse) error
-	grpc.ServerStream
+func (c *calculatorServiceClient) ComplexAdd(ctx context.Context, in *ComplexAddRequest, opts ...grpc.CallOption) (*ComplexAddResponse, error) {
+	out := new(ComplexAddResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ComplexAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type calculatorServicePrimeNumberDecompositionServer struct {
-	grpc.ServerStream
+func (c *calculatorServiceClient) ComplexMultiply(ctx context.Context, in *ComplexMultiplyRequest, opts ...grpc.CallOption) (*ComplexMultiplyResponse, error) {
+	out := new(ComplexMultiplyResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ComplexMultiply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *calculatorServicePrimeNumberDecompositionServer) Send(m *PrimeNumberDecompositionResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (c *calculatorServiceClient) ComplexDivide(ctx context.Context, in *ComplexDivideRequest, opts ...grpc.CallOption) (*ComplexDivideResponse, error) {
+	out := new(ComplexDivideResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ComplexDivide", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _CalculatorService_ComputeAverage_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(CalculatorServiceServer).ComputeAverage(&calculatorServiceComputeAverageServer{stream})
+func (c *calculatorServiceClient) ComplexMagnitude(ctx context.Context, in *ComplexMagnitudeRequest, opts ...grpc.CallOption) (*ComplexMagnitudeResponse, error) {
+	out := new(ComplexMagnitudeResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ComplexMagnitude", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type CalculatorService_ComputeAverageServer interface {
-	SendAndClose(*ComputeAverageResponse) error
-	Recv() (*ComputeAverageRequest, error)
-	grpc.ServerStream
+func (c *calculatorServiceClient) ComplexPhase(ctx context.Context, in *ComplexPhaseRequest, opts ...grpc.CallOption) (*ComplexPhaseResponse, error) {
+	out := new(ComplexPhaseResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ComplexPhase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type calculatorServiceComputeAverageServer struct {
-	grpc.ServerStream
+func (c *calculatorServiceClient) ComplexToPolar(ctx context.Context, in *ComplexToPolarRequest, opts ...grpc.CallOption) (*ComplexToPolarResponse, error) {
+	out := new(ComplexToPolarResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ComplexToPolar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *calculatorServiceComputeAverageServer) SendAndClose(m *ComputeAverageResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (c *calculatorServiceClient) ComplexFromPolar(ctx context.Context, in *ComplexFromPolarRequest, opts ...grpc.CallOption) (*ComplexFromPolarResponse, error) {
+	out := new(ComplexFromPolarResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ComplexFromPolar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *calculatorServiceComputeAverageServer) Recv() (*ComputeAverageRequest, error) {
-	m := new(ComputeAverageRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *calculatorServiceClient) ComplexRoots(ctx context.Context, in *ComplexRootsRequest, opts ...grpc.CallOption) (*ComplexRootsResponse, error) {
+	out := new(ComplexRootsResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ComplexRoots", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func _CalculatorService_FindMaximum_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(CalculatorServiceServer).FindMaximum(&calculatorServiceFindMaximumServer{stream})
+func (c *calculatorServiceClient) RationalAdd(ctx context.Context, in *RationalAddRequest, opts ...grpc.CallOption) (*RationalAddResponse, error) {
+	out := new(RationalAddResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/RationalAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type CalculatorService_FindMaximumServer interface {
-	Send(*FindMaximumResponse) error
-	Recv() (*FindMaximumRequest, error)
-	grpc.ServerStream
+func (c *calculatorServiceClient) RationalSubtract(ctx context.Context, in *RationalSubtractRequest, opts ...grpc.CallOption) (*RationalSubtractResponse, error) {
+	out := new(RationalSubtractResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/RationalSubtract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type calculatorServiceFindMaximumServer struct {
-	grpc.ServerStream
+func (c *calculatorServiceClient) RationalMultiply(ctx context.Context, in *RationalMultiplyRequest, opts ...grpc.CallOption) (*RationalMultiplyResponse, error) {
+	out := new(RationalMultiplyResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/RationalMultiply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *calculatorServiceFindMaximumServer) Send(m *FindMaximumResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (c *calculatorServiceClient) RationalDivide(ctx context.Context, in *RationalDivideRequest, opts ...grpc.CallOption) (*RationalDivideResponse, error) {
+	out := new(RationalDivideResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/RationalDivide", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *calculatorServiceFindMaximumServer) Recv() (*FindMaximumRequest, error) {
-	m := new(FindMaximumRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *calculatorServiceClient) RationalSimplify(ctx context.Context, in *RationalSimplifyRequest, opts ...grpc.CallOption) (*RationalSimplifyResponse, error) {
+	out := new(RationalSimplifyResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/RationalSimplify", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func _CalculatorService_SquareRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SquareRootRequest)
-	if err := dec(in); err != nil {
+func (c *calculatorServiceClient) RationalToDecimal(ctx context.Context, in *RationalToDecimalRequest, opts ...grpc.CallOption) (*RationalToDecimalResponse, error) {
+	out := new(RationalToDecimalResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/RationalToDecimal", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CalculatorServiceServer).SquareRoot(ctx, in)
+	return out, nil
+}
+
+func (c *calculatorServiceClient) RationalFromDecimal(ctx context.Context, in *RationalFromDecimalRequest, opts ...grpc.CallOption) (*RationalFromDecimalResponse, error) {
+	out := new(RationalFromDecimalResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/RationalFromDecimal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) IsPrime(ctx context.Context, in *IsPrimeRequest, opts ...grpc.CallOption) (*IsPrimeResponse, error) {
+	out := new(IsPrimeResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/IsPrime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) NextPrime(ctx context.Context, in *NextPrimeRequest, opts ...grpc.CallOption) (*NextPrimeResponse, error) {
+	out := new(NextPrimeResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/NextPrime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Gcd(ctx context.Context, in *GcdRequest, opts ...grpc.CallOption) (*GcdResponse, error) {
+	out := new(GcdResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/Gcd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Lcm(ctx context.Context, in *LcmRequest, opts ...grpc.CallOption) (*LcmResponse, error) {
+	out := new(LcmResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/Lcm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) ModPow(ctx context.Context, in *ModPowRequest, opts ...grpc.CallOption) (*ModPowResponse, error) {
+	out := new(ModPowResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ModPow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) ModInverse(ctx context.Context, in *ModInverseRequest, opts ...grpc.CallOption) (*ModInverseResponse, error) {
+	out := new(ModInverseResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ModInverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Session(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_SessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[7], "/calculator.CalculatorService/Session", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceSessionClient{stream}
+	return x, nil
+}
+
+type CalculatorService_SessionClient interface {
+	Send(*SessionRequest) error
+	Recv() (*SessionResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceSessionClient) Send(m *SessionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorServiceSessionClient) Recv() (*SessionResponse, error) {
+	m := new(SessionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CalculatorServiceServer is the server API for CalculatorService service.
+// All implementations must embed UnimplementedCalculatorServiceServer
+// for forward compatibility
+type CalculatorServiceServer interface {
+	Sum(context.Context, *SumRequest) (*SumResponse, error)
+	PrimeNumberDecomposition(*PrimeNumberDecompositionRequest, CalculatorService_PrimeNumberDecompositionServer) error
+	// Streams the prime factors of any 64-bit number in ascending order, each
+	// repeated as many times as it divides the number.
+	Factorize(*FactorizeRequest, CalculatorService_FactorizeServer) error
+	StreamPrimes(*StreamPrimesRequest, CalculatorService_StreamPrimesServer) error
+	CountPrimes(context.Context, *CountPrimesRequest) (*CountPrimesResponse, error)
+	ComputeAverage(CalculatorService_ComputeAverageServer) error
+	// Summarizes the streamed numbers in constant memory.
+	ComputeStatistics(CalculatorService_ComputeStatisticsServer) error
+	FindMaximum(CalculatorService_FindMaximumServer) error
+	// Answers every number with the extremes of a sliding window ending with
+	// it.
+	WindowedExtremes(CalculatorService_WindowedExtremesServer) error
+	SquareRoot(context.Context, *SquareRootRequest) (*SquareRootResponse, error)
+	BigAdd(context.Context, *BigAddRequest) (*BigAddResponse, error)
+	BigSubtract(context.Context, *BigSubtractRequest) (*BigSubtractResponse, error)
+	BigMultiply(context.Context, *BigMultiplyRequest) (*BigMultiplyResponse, error)
+	BigDivide(context.Context, *BigDivideRequest) (*BigDivideResponse, error)
+	BigPower(context.Context, *BigPowerRequest) (*BigPowerResponse, error)
+	ComplexAdd(context.Context, *ComplexAddRequest) (*ComplexAddResponse, error)
+	ComplexMultiply(context.Context, *ComplexMultiplyRequest) (*ComplexMultiplyResponse, error)
+	ComplexDivide(context.Context, *ComplexDivideRequest) (*ComplexDivideResponse, error)
+	ComplexMagnitude(context.Context, *ComplexMagnitudeRequest) (*ComplexMagnitudeResponse, error)
+	ComplexPhase(context.Context, *ComplexPhaseRequest) (*ComplexPhaseResponse, error)
+	ComplexToPolar(context.Context, *ComplexToPolarRequest) (*ComplexToPolarResponse, error)
+	ComplexFromPolar(context.Context, *ComplexFromPolarRequest) (*ComplexFromPolarResponse, error)
+	ComplexRoots(context.Context, *ComplexRootsRequest) (*ComplexRootsResponse, error)
+	RationalAdd(context.Context, *RationalAddRequest) (*RationalAddResponse, error)
+	RationalSubtract(context.Context, *RationalSubtractRequest) (*RationalSubtractResponse, error)
+	RationalMultiply(context.Context, *RationalMultiplyRequest) (*RationalMultiplyResponse, error)
+	RationalDivide(context.Context, *RationalDivideRequest) (*RationalDivideResponse, error)
+	RationalSimplify(context.Context, *RationalSimplifyRequest) (*RationalSimplifyResponse, error)
+	RationalToDecimal(context.Context, *RationalToDecimalRequest) (*RationalToDecimalResponse, error)
+	RationalFromDecimal(context.Context, *RationalFromDecimalRequest) (*RationalFromDecimalResponse, error)
+	IsPrime(context.Context, *IsPrimeRequest) (*IsPrimeResponse, error)
+	NextPrime(context.Context, *NextPrimeRequest) (*NextPrimeResponse, error)
+	Gcd(context.Context, *GcdRequest) (*GcdResponse, error)
+	Lcm(context.Context, *LcmRequest) (*LcmResponse, error)
+	ModPow(context.Context, *ModPowRequest) (*ModPowResponse, error)
+	ModInverse(context.Context, *ModInverseRequest) (*ModInverseResponse, error)
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+	// Evaluates each statement in order and answers it with one response.
+	// Variables and functions live until the stream ends.
+	Session(CalculatorService_SessionServer) error
+	mustEmbedUnimplementedCalculatorServiceServer()
+}
+
+// UnimplementedCalculatorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCalculatorServiceServer struct {
+}
+
+func (UnimplementedCalculatorServiceServer) Sum(context.Context, *SumRequest) (*SumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sum not implemented")
+}
+func (UnimplementedCalculatorServiceServer) PrimeNumberDecomposition(*PrimeNumberDecompositionRequest, CalculatorService_PrimeNumberDecompositionServer) error {
+	return status.Errorf(codes.Unimplemented, "method PrimeNumberDecomposition not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Factorize(*FactorizeRequest, CalculatorService_FactorizeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Factorize not implemented")
+}
+func (UnimplementedCalculatorServiceServer) StreamPrimes(*StreamPrimesRequest, CalculatorService_StreamPrimesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrimes not implemented")
+}
+func (UnimplementedCalculatorServiceServer) CountPrimes(context.Context, *CountPrimesRequest) (*CountPrimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountPrimes not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComputeAverage(CalculatorService_ComputeAverageServer) error {
+	return status.Errorf(codes.Unimplemented, "method ComputeAverage not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComputeStatistics(CalculatorService_ComputeStatisticsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ComputeStatistics not implemented")
+}
+func (UnimplementedCalculatorServiceServer) FindMaximum(CalculatorService_FindMaximumServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindMaximum not implemented")
+}
+func (UnimplementedCalculatorServiceServer) WindowedExtremes(CalculatorService_WindowedExtremesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WindowedExtremes not implemented")
+}
+func (UnimplementedCalculatorServiceServer) SquareRoot(context.Context, *SquareRootRequest) (*SquareRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SquareRoot not implemented")
+}
+func (UnimplementedCalculatorServiceServer) BigAdd(context.Context, *BigAddRequest) (*BigAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BigAdd not implemented")
+}
+func (UnimplementedCalculatorServiceServer) BigSubtract(context.Context, *BigSubtractRequest) (*BigSubtractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BigSubtract not implemented")
+}
+func (UnimplementedCalculatorServiceServer) BigMultiply(context.Context, *BigMultiplyRequest) (*BigMultiplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BigMultiply not implemented")
+}
+func (UnimplementedCalculatorServiceServer) BigDivide(context.Context, *BigDivideRequest) (*BigDivideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BigDivide not implemented")
+}
+func (UnimplementedCalculatorServiceServer) BigPower(context.Context, *BigPowerRequest) (*BigPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BigPower not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComplexAdd(context.Context, *ComplexAddRequest) (*ComplexAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplexAdd not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComplexMultiply(context.Context, *ComplexMultiplyRequest) (*ComplexMultiplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplexMultiply not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComplexDivide(context.Context, *ComplexDivideRequest) (*ComplexDivideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplexDivide not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComplexMagnitude(context.Context, *ComplexMagnitudeRequest) (*ComplexMagnitudeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplexMagnitude not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComplexPhase(context.Context, *ComplexPhaseRequest) (*ComplexPhaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplexPhase not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComplexToPolar(context.Context, *ComplexToPolarRequest) (*ComplexToPolarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplexToPolar not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComplexFromPolar(context.Context, *ComplexFromPolarRequest) (*ComplexFromPolarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplexFromPolar not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComplexRoots(context.Context, *ComplexRootsRequest) (*ComplexRootsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplexRoots not implemented")
+}
+func (UnimplementedCalculatorServiceServer) RationalAdd(context.Context, *RationalAddRequest) (*RationalAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RationalAdd not implemented")
+}
+func (UnimplementedCalculatorServiceServer) RationalSubtract(context.Context, *RationalSubtractRequest) (*RationalSubtractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RationalSubtract not implemented")
+}
+func (UnimplementedCalculatorServiceServer) RationalMultiply(context.Context, *RationalMultiplyRequest) (*RationalMultiplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RationalMultiply not implemented")
+}
+func (UnimplementedCalculatorServiceServer) RationalDivide(context.Context, *RationalDivideRequest) (*RationalDivideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RationalDivide not implemented")
+}
+func (UnimplementedCalculatorServiceServer) RationalSimplify(context.Context, *RationalSimplifyRequest) (*RationalSimplifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RationalSimplify not implemented")
+}
+func (UnimplementedCalculatorServiceServer) RationalToDecimal(context.Context, *RationalToDecimalRequest) (*RationalToDecimalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RationalToDecimal not implemented")
+}
+func (UnimplementedCalculatorServiceServer) RationalFromDecimal(context.Context, *RationalFromDecimalRequest) (*RationalFromDecimalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RationalFromDecimal not implemented")
+}
+func (UnimplementedCalculatorServiceServer) IsPrime(context.Context, *IsPrimeRequest) (*IsPrimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPrime not implemented")
+}
+func (UnimplementedCalculatorServiceServer) NextPrime(context.Context, *NextPrimeRequest) (*NextPrimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextPrime not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Gcd(context.Context, *GcdRequest) (*GcdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gcd not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Lcm(context.Context, *LcmRequest) (*LcmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lcm not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ModPow(context.Context, *ModPowRequest) (*ModPowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModPow not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ModInverse(context.Context, *ModInverseRequest) (*ModInverseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModInverse not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Session(CalculatorService_SessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method Session not implemented")
+}
+func (UnimplementedCalculatorServiceServer) mustEmbedUnimplementedCalculatorServiceServer() {}
+
+// UnsafeCalculatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalculatorServiceServer will
+// result in compilation errors.
+type UnsafeCalculatorServiceServer interface {
+	mustEmbedUnimplementedCalculatorServiceServer()
+}
+
+func RegisterCalculatorServiceServer(s grpc.ServiceRegistrar, srv CalculatorServiceServer) {
+	s.RegisterService(&CalculatorService_ServiceDesc, srv)
+}
+
+func _CalculatorService_Sum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Sum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Sum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Sum(ctx, req.(*SumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_PrimeNumberDecomposition_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PrimeNumberDecompositionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CalculatorServiceServer).PrimeNumberDecomposition(m, &calculatorServicePrimeNumberDecompositionServer{stream})
+}
+
+type CalculatorService_PrimeNumberDecompositionServer interface {
+	Send(*PrimeNumberDecompositionResponse) error
+	grpc.ServerStream
+}
+
+type calculatorServicePrimeNumberDecompositionServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServicePrimeNumberDecompositionServer) Send(m *PrimeNumberDecompositionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CalculatorService_Factorize_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FactorizeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CalculatorServiceServer).Factorize(m, &calculatorServiceFactorizeServer{stream})
+}
+
+type CalculatorService_FactorizeServer interface {
+	Send(*FactorizeResponse) error
+	grpc.ServerStream
+}
+
+type calculatorServiceFactorizeServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceFactorizeServer) Send(m *FactorizeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CalculatorService_StreamPrimes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPrimesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CalculatorServiceServer).StreamPrimes(m, &calculatorServiceStreamPrimesServer{stream})
+}
+
+type CalculatorService_StreamPrimesServer interface {
+	Send(*StreamPrimesResponse) error
+	grpc.ServerStream
+}
+
+type calculatorServiceStreamPrimesServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceStreamPrimesServer) Send(m *StreamPrimesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CalculatorService_CountPrimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountPrimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).CountPrimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/CountPrimes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).CountPrimes(ctx, req.(*CountPrimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ComputeAverage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).ComputeAverage(&calculatorServiceComputeAverageServer{stream})
+}
+
+type CalculatorService_ComputeAverageServer interface {
+	SendAndClose(*ComputeAverageResponse) error
+	Recv() (*ComputeAverageRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceComputeAverageServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceComputeAverageServer) SendAndClose(m *ComputeAverageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceComputeAverageServer) Recv() (*ComputeAverageRequest, error) {
+	m := new(ComputeAverageRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_ComputeStatistics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).ComputeStatistics(&calculatorServiceComputeStatisticsServer{stream})
+}
+
+type CalculatorService_ComputeStatisticsServer interface {
+	SendAndClose(*ComputeStatisticsResponse) error
+	Recv() (*ComputeStatisticsRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceComputeStatisticsServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceComputeStatisticsServer) SendAndClose(m *ComputeStatisticsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceComputeStatisticsServer) Recv() (*ComputeStatisticsRequest, error) {
+	m := new(ComputeStatisticsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_FindMaximum_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).FindMaximum(&calculatorServiceFindMaximumServer{stream})
+}
+
+type CalculatorService_FindMaximumServer interface {
+	Send(*FindMaximumResponse) error
+	Recv() (*FindMaximumRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceFindMaximumServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceFindMaximumServer) Send(m *FindMaximumResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceFindMaximumServer) Recv() (*FindMaximumRequest, error) {
+	m := new(FindMaximumRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_WindowedExtremes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).WindowedExtremes(&calculatorServiceWindowedExtremesServer{stream})
+}
+
+type CalculatorService_WindowedExtremesServer interface {
+	Send(*WindowedExtremesResponse) error
+	Recv() (*WindowedExtremesRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceWindowedExtremesServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceWindowedExtremesServer) Send(m *WindowedExtremesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceWindowedExtremesServer) Recv() (*WindowedExtremesRequest, error) {
+	m := new(WindowedExtremesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_SquareRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquareRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).SquareRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/SquareRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).SquareRoot(ctx, req.(*SquareRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_BigAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).BigAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/BigAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).BigAdd(ctx, req.(*BigAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_BigSubtract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigSubtractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).BigSubtract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/BigSubtract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).BigSubtract(ctx, req.(*BigSubtractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_BigMultiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigMultiplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).BigMultiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/BigMultiply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).BigMultiply(ctx, req.(*BigMultiplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_BigDivide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigDivideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).BigDivide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/BigDivide",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).BigDivide(ctx, req.(*BigDivideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_BigPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).BigPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/BigPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).BigPower(ctx, req.(*BigPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ComplexAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ComplexAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ComplexAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ComplexAdd(ctx, req.(*ComplexAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ComplexMultiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexMultiplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ComplexMultiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ComplexMultiply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ComplexMultiply(ctx, req.(*ComplexMultiplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ComplexDivide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexDivideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ComplexDivide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ComplexDivide",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ComplexDivide(ctx, req.(*ComplexDivideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ComplexMagnitude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexMagnitudeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ComplexMagnitude(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ComplexMagnitude",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ComplexMagnitude(ctx, req.(*ComplexMagnitudeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ComplexPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexPhaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ComplexPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ComplexPhase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ComplexPhase(ctx, req.(*ComplexPhaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ComplexToPolar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexToPolarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ComplexToPolar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ComplexToPolar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ComplexToPolar(ctx, req.(*ComplexToPolarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ComplexFromPolar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexFromPolarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ComplexFromPolar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ComplexFromPolar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ComplexFromPolar(ctx, req.(*ComplexFromPolarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ComplexRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexRootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ComplexRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ComplexRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ComplexRoots(ctx, req.(*ComplexRootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_RationalAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RationalAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).RationalAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/RationalAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).RationalAdd(ctx, req.(*RationalAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_RationalSubtract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RationalSubtractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).RationalSubtract(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/calculator.CalculatorService/SquareRoot",
+		FullMethod: "/calculator.CalculatorService/RationalSubtract",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CalculatorServiceServer).SquareRoot(ctx, req.(*SquareRootRequest))
+		return srv.(CalculatorServiceServer).RationalSubtract(ctx, req.(*RationalSubtractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_RationalMultiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RationalMultiplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).RationalMultiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/RationalMultiply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).RationalMultiply(ctx, req.(*RationalMultiplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_RationalDivide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RationalDivideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).RationalDivide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/RationalDivide",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).RationalDivide(ctx, req.(*RationalDivideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_RationalSimplify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RationalSimplifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).RationalSimplify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/RationalSimplify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).RationalSimplify(ctx, req.(*RationalSimplifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_RationalToDecimal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RationalToDecimalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).RationalToDecimal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/RationalToDecimal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).RationalToDecimal(ctx, req.(*RationalToDecimalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_RationalFromDecimal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RationalFromDecimalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).RationalFromDecimal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/RationalFromDecimal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).RationalFromDecimal(ctx, req.(*RationalFromDecimalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_IsPrime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsPrimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).IsPrime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/IsPrime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).IsPrime(ctx, req.(*IsPrimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_NextPrime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextPrimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).NextPrime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/NextPrime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).NextPrime(ctx, req.(*NextPrimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Gcd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GcdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Gcd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Gcd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Gcd(ctx, req.(*GcdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Lcm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LcmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Lcm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Lcm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Lcm(ctx, req.(*LcmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ModPow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModPowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ModPow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ModPow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ModPow(ctx, req.(*ModPowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ModInverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModInverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ModInverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ModInverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ModInverse(ctx, req.(*ModInverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Evaluate(ctx, req.(*EvaluateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CalculatorService_Session_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).Session(&calculatorServiceSessionServer{stream})
+}
+
+type CalculatorService_SessionServer interface {
+	Send(*SessionResponse) error
+	Recv() (*SessionRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceSessionServer) Send(m *SessionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceSessionServer) Recv() (*SessionRequest, error) {
+	m := new(SessionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CalculatorService_ServiceDesc is the grpc.ServiceDesc for CalculatorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -312,10 +1527,122 @@ var CalculatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Sum",
 			Handler:    _CalculatorService_Sum_Handler,
 		},
+		{
+			MethodName: "CountPrimes",
+			Handler:    _CalculatorService_CountPrimes_Handler,
+		},
 		{
 			MethodName: "SquareRoot",
 			Handler:    _CalculatorService_SquareRoot_Handler,
 		},
+		{
+			MethodName: "BigAdd",
+			Handler:    _CalculatorService_BigAdd_Handler,
+		},
+		{
+			MethodName: "BigSubtract",
+			Handler:    _CalculatorService_BigSubtract_Handler,
+		},
+		{
+			MethodName: "BigMultiply",
+			Handler:    _CalculatorService_BigMultiply_Handler,
+		},
+		{
+			MethodName: "BigDivide",
+			Handler:    _CalculatorService_BigDivide_Handler,
+		},
+		{
+			MethodName: "BigPower",
+			Handler:    _CalculatorService_BigPower_Handler,
+		},
+		{
+			MethodName: "ComplexAdd",
+			Handler:    _CalculatorService_ComplexAdd_Handler,
+		},
+		{
+			MethodName: "ComplexMultiply",
+			Handler:    _CalculatorService_ComplexMultiply_Handler,
+		},
+		{
+			MethodName: "ComplexDivide",
+			Handler:    _CalculatorService_ComplexDivide_Handler,
+		},
+		{
+			MethodName: "ComplexMagnitude",
+			Handler:    _CalculatorService_ComplexMagnitude_Handler,
+		},
+		{
+			MethodName: "ComplexPhase",
+			Handler:    _CalculatorService_ComplexPhase_Handler,
+		},
+		{
+			MethodName: "ComplexToPolar",
+			Handler:    _CalculatorService_ComplexToPolar_Handler,
+		},
+		{
+			MethodName: "ComplexFromPolar",
+			Handler:    _CalculatorService_ComplexFromPolar_Handler,
+		},
+		{
+			MethodName: "ComplexRoots",
+			Handler:    _CalculatorService_ComplexRoots_Handler,
+		},
+		{
+			MethodName: "RationalAdd",
+			Handler:    _CalculatorService_RationalAdd_Handler,
+		},
+		{
+			MethodName: "RationalSubtract",
+			Handler:    _CalculatorService_RationalSubtract_Handler,
+		},
+		{
+			MethodName: "RationalMultiply",
+			Handler:    _CalculatorService_RationalMultiply_Handler,
+		},
+		{
+			MethodName: "RationalDivide",
+			Handler:    _CalculatorService_RationalDivide_Handler,
+		},
+		{
+			MethodName: "RationalSimplify",
+			Handler:    _CalculatorService_RationalSimplify_Handler,
+		},
+		{
+			MethodName: "RationalToDecimal",
+			Handler:    _CalculatorService_RationalToDecimal_Handler,
+		},
+		{
+			MethodName: "RationalFromDecimal",
+			Handler:    _CalculatorService_RationalFromDecimal_Handler,
+		},
+		{
+			MethodName: "IsPrime",
+			Handler:    _CalculatorService_IsPrime_Handler,
+		},
+		{
+			MethodName: "NextPrime",
+			Handler:    _CalculatorService_NextPrime_Handler,
+		},
+		{
+			MethodName: "Gcd",
+			Handler:    _CalculatorService_Gcd_Handler,
+		},
+		{
+			MethodName: "Lcm",
+			Handler:    _CalculatorService_Lcm_Handler,
+		},
+		{
+			MethodName: "ModPow",
+			Handler:    _CalculatorService_ModPow_Handler,
+		},
+		{
+			MethodName: "ModInverse",
+			Handler:    _CalculatorService_ModInverse_Handler,
+		},
+		{
+			MethodName: "Evaluate",
+			Handler:    _CalculatorService_Evaluate_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -323,17 +1650,44 @@ var CalculatorService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _CalculatorService_PrimeNumberDecomposition_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Factorize",
+			Handler:       _CalculatorService_Factorize_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPrimes",
+			Handler:       _CalculatorService_StreamPrimes_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ComputeAverage",
 			Handler:       _CalculatorService_ComputeAverage_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ComputeStatistics",
+			Handler:       _CalculatorService_ComputeStatistics_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "FindMaximum",
 			Handler:       _CalculatorService_FindMaximum_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WindowedExtremes",
+			Handler:       _CalculatorService_WindowedExtremes_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Session",
+			Handler:       _CalculatorService_Session_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "calculator/calculatorpb/calculator.proto",
 }
@@ -4,4 +4,6 @@ protoc --go_out=. --go-grpc_out=. greet/greetpb/greet.proto
 
 protoc --go_out=. --go-grpc_out=. calculator/calculatorpb/calculator.proto
 
+protoc --go_out=. --go-grpc_out=. blog/blogpb/blog.proto
+
 echo "Generated"
\ No newline at end of file